@@ -490,6 +490,21 @@ func (c *internalFuncCompiler) emitInstr(instr binary.Instruction) {
 		c.printf("// %s\n", opname) // TODO
 	case binary.F64ReinterpretI64:
 		c.printf("// %s\n", opname) // TODO
+	case binary.I32Extend8S:
+		c.printf("stack[%d] = u64(uint32(int8(stack[%d]))) // %s\n",
+			c.stackPtr-1, c.stackPtr-1, opname)
+	case binary.I32Extend16S:
+		c.printf("stack[%d] = u64(uint32(int16(stack[%d]))) // %s\n",
+			c.stackPtr-1, c.stackPtr-1, opname)
+	case binary.I64Extend8S:
+		c.printf("stack[%d] = u64(int8(stack[%d])) // %s\n",
+			c.stackPtr-1, c.stackPtr-1, opname)
+	case binary.I64Extend16S:
+		c.printf("stack[%d] = u64(int16(stack[%d])) // %s\n",
+			c.stackPtr-1, c.stackPtr-1, opname)
+	case binary.I64Extend32S:
+		c.printf("stack[%d] = u64(int32(stack[%d])) // %s\n",
+			c.stackPtr-1, c.stackPtr-1, opname)
 	default:
 		c.printf("// %s ???", opname)
 	}
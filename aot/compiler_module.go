@@ -1,6 +1,11 @@
 package aot
 
-import "github.com/zxh0/wasm.go/binary"
+import (
+	"fmt"
+	"math"
+
+	"github.com/zxh0/wasm.go/binary"
+)
 
 type moduleCompiler struct {
 	printer
@@ -81,10 +86,9 @@ func Instantiate(iMap instance.Map) (instance.Instance, error) {
 			i, imp.Module, imp.Name, "\n")
 	}
 	for i, g := range c.module.GlobalSec {
-		c.printf("	gt := binary.GlobalType{ValType: %d, Mut:%d}\n",
-			g.Type.ValType, g.Type.Mut)
-		c.printf("	m.globals[%d] = interpreter.NewGlobal()\n",
-			len(c.importedGlobals)+i)
+		c.printf("	m.globals[%d] = interpreter.NewGlobal(%d, %t, %s)\n",
+			len(c.importedGlobals)+i, g.Type.ValType,
+			g.Type.Mut == binary.MutVar, genConstExpr(g.Expr))
 	}
 
 	c.println("	return m, nil // TODO\n}")
@@ -168,3 +172,44 @@ func f64(i uint64) float64 { return math.Float64frombits(i) }
 func u64(f float64) uint64 { return math.Float64bits(f) }
 `)
 }
+
+// translate constant expression into Go expression of type uint64
+func genConstExpr(expr []binary.Instruction) string {
+	stack := make([]string, 0, 2)
+	pop2 := func() (string, string) {
+		n := len(stack)
+		a, b := stack[n-2], stack[n-1]
+		stack = stack[:n-2]
+		return a, b
+	}
+	for _, instr := range expr {
+		switch instr.Opcode {
+		case binary.I32Const:
+			stack = append(stack, fmt.Sprintf("0x%x", uint32(instr.Args.(int32))))
+		case binary.I64Const:
+			stack = append(stack, fmt.Sprintf("0x%x", uint64(instr.Args.(int64))))
+		case binary.F32Const:
+			stack = append(stack, fmt.Sprintf("0x%x", math.Float32bits(instr.Args.(float32))))
+		case binary.F64Const:
+			stack = append(stack, fmt.Sprintf("0x%x", math.Float64bits(instr.Args.(float64))))
+		case binary.GlobalGet:
+			stack = append(stack, fmt.Sprintf("m.globals[%d].Get()", instr.Args.(uint32)))
+		case binary.I32Add, binary.I32Sub, binary.I32Mul:
+			a, b := pop2()
+			stack = append(stack, fmt.Sprintf("uint64(uint32(%s) %s uint32(%s))",
+				a, constExprOps[instr.Opcode], b))
+		case binary.I64Add, binary.I64Sub, binary.I64Mul:
+			a, b := pop2()
+			stack = append(stack, fmt.Sprintf("(uint64(%s) %s uint64(%s))",
+				a, constExprOps[instr.Opcode], b))
+		default:
+			panic(fmt.Errorf("constant expression required"))
+		}
+	}
+	return stack[0]
+}
+
+var constExprOps = map[byte]string{
+	binary.I32Add: "+", binary.I32Sub: "-", binary.I32Mul: "*",
+	binary.I64Add: "+", binary.I64Sub: "-", binary.I64Mul: "*",
+}
@@ -0,0 +1,96 @@
+// Package asm provides typed emitters for building function bodies,
+// e.g. asm.Assemble(func(a *asm.Assembler) { a.I32Const(1); a.Drop() }).
+package asm
+
+import (
+	"fmt"
+
+	"github.com/zxh0/wasm.go/binary"
+)
+
+type Assembler struct {
+	instrs []binary.Instruction
+}
+
+func Assemble(f func(a *Assembler)) binary.Expr {
+	a := &Assembler{}
+	f(a)
+	return a.Expr()
+}
+
+func (a *Assembler) Expr() binary.Expr {
+	return a.instrs
+}
+
+// Op emits an instruction without immediates.
+func (a *Assembler) Op(opcode byte) {
+	if hasImmediates(opcode) {
+		panic(fmt.Errorf("%s has immediates", binary.Instruction{Opcode: opcode}.GetOpname()))
+	}
+	a.emit(opcode, nil)
+}
+
+func (a *Assembler) emit(opcode byte, args interface{}) {
+	a.instrs = append(a.instrs,
+		binary.Instruction{Opcode: opcode, Args: args})
+}
+
+func hasImmediates(opcode byte) bool {
+	switch opcode {
+	case binary.Block, binary.Loop, binary.If,
+		binary.Br, binary.BrIf, binary.BrTable,
+		binary.Call, binary.CallIndirect, binary.CallRef, binary.ReturnCallRef,
+		binary.LocalGet, binary.LocalSet, binary.LocalTee,
+		binary.GlobalGet, binary.GlobalSet,
+		binary.I32Const, binary.I64Const, binary.F32Const, binary.F64Const,
+		binary.RefNull, binary.RefFunc, binary.BrOnNull, binary.BrOnNonNull:
+		return true
+	}
+	return opcode >= binary.I32Load && opcode <= binary.I64Store32
+}
+
+/* control */
+
+func (a *Assembler) Block(rt binary.BlockType, body func(b *Assembler)) {
+	a.emit(binary.Block, binary.BlockArgs{RT: rt, Instrs: Assemble(body)})
+}
+
+func (a *Assembler) Loop(rt binary.BlockType, body func(b *Assembler)) {
+	a.emit(binary.Loop, binary.BlockArgs{RT: rt, Instrs: Assemble(body)})
+}
+
+// If emits if-then-else, els can be nil.
+func (a *Assembler) If(rt binary.BlockType, then, els func(b *Assembler)) {
+	args := binary.IfArgs{RT: rt, Instrs1: Assemble(then)}
+	if els != nil {
+		args.Instrs2 = Assemble(els)
+	}
+	a.emit(binary.If, args)
+}
+
+func (a *Assembler) Br(l binary.LabelIdx)          { a.emit(binary.Br, l) }
+func (a *Assembler) BrIf(l binary.LabelIdx)        { a.emit(binary.BrIf, l) }
+func (a *Assembler) BrOnNull(l binary.LabelIdx)    { a.emit(binary.BrOnNull, l) }
+func (a *Assembler) BrOnNonNull(l binary.LabelIdx) { a.emit(binary.BrOnNonNull, l) }
+
+func (a *Assembler) BrTable(labels []binary.LabelIdx, def binary.LabelIdx) {
+	a.emit(binary.BrTable, binary.BrTableArgs{Labels: labels, Default: def})
+}
+
+func (a *Assembler) Call(f binary.FuncIdx)          { a.emit(binary.Call, f) }
+func (a *Assembler) CallIndirect(t binary.TypeIdx)  { a.emit(binary.CallIndirect, t) }
+func (a *Assembler) CallRef(t binary.TypeIdx)       { a.emit(binary.CallRef, t) }
+func (a *Assembler) ReturnCallRef(t binary.TypeIdx) { a.emit(binary.ReturnCallRef, t) }
+func (a *Assembler) LocalGet(x binary.LocalIdx)     { a.emit(binary.LocalGet, x) }
+func (a *Assembler) LocalSet(x binary.LocalIdx)     { a.emit(binary.LocalSet, x) }
+func (a *Assembler) LocalTee(x binary.LocalIdx)     { a.emit(binary.LocalTee, x) }
+func (a *Assembler) GlobalGet(x binary.GlobalIdx)   { a.emit(binary.GlobalGet, x) }
+func (a *Assembler) GlobalSet(x binary.GlobalIdx)   { a.emit(binary.GlobalSet, x) }
+func (a *Assembler) I32Const(n int32)               { a.emit(binary.I32Const, n) }
+func (a *Assembler) I64Const(n int64)               { a.emit(binary.I64Const, n) }
+func (a *Assembler) F32Const(z float32)             { a.emit(binary.F32Const, z) }
+func (a *Assembler) F64Const(z float64)             { a.emit(binary.F64Const, z) }
+func (a *Assembler) RefFunc(f binary.FuncIdx)       { a.emit(binary.RefFunc, f) }
+
+// RefNull emits ref.null of the given nullable ref type.
+func (a *Assembler) RefNull(vt binary.ValType) { a.emit(binary.RefNull, vt) }
@@ -0,0 +1,76 @@
+package asm
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zxh0/wasm.go/binary"
+	"github.com/zxh0/wasm.go/interpreter"
+	"github.com/zxh0/wasm.go/validator"
+)
+
+var i32 = []binary.ValType{binary.ValTypeI32}
+
+func TestAssemble(t *testing.T) {
+	expr := Assemble(func(a *Assembler) {
+		a.Block(nil, func(a *Assembler) {
+			a.LocalGet(0)
+			a.BrIf(0)
+		})
+		a.If(i32, func(a *Assembler) {
+			a.I32Const(1)
+		}, nil)
+		a.BrTable([]binary.LabelIdx{0, 1}, 2)
+		a.I32Load(binary.MemArg{Align: 2, Offset: 8})
+		a.I32Add()
+	})
+	require.Equal(t, binary.Expr{
+		{Opcode: binary.Block, Args: binary.BlockArgs{Instrs: binary.Expr{
+			{Opcode: binary.LocalGet, Args: uint32(0)},
+			{Opcode: binary.BrIf, Args: uint32(0)},
+		}}},
+		{Opcode: binary.If, Args: binary.IfArgs{RT: i32, Instrs1: binary.Expr{
+			{Opcode: binary.I32Const, Args: int32(1)},
+		}}},
+		{Opcode: binary.BrTable, Args: binary.BrTableArgs{
+			Labels: []uint32{0, 1}, Default: 2,
+		}},
+		{Opcode: binary.I32Load, Args: binary.MemArg{Align: 2, Offset: 8}},
+		{Opcode: binary.I32Add},
+	}, expr)
+
+	require.Panics(t, func() { (&Assembler{}).Op(binary.I32Const) })
+}
+
+// switch (x) { case 0: return 10; case 1: return 20; default: return -1 }
+func TestAssembleAndRun(t *testing.T) {
+	b := binary.NewBuilder()
+	f := b.AddFunc(binary.FuncType{ParamTypes: i32, ResultTypes: i32}, nil,
+		Assemble(func(a *Assembler) {
+			a.Block(nil, func(a *Assembler) {
+				a.Block(nil, func(a *Assembler) {
+					a.Block(nil, func(a *Assembler) {
+						a.LocalGet(0)
+						a.BrTable([]binary.LabelIdx{0, 1}, 2)
+					})
+					a.I32Const(10)
+					a.Return()
+				})
+				a.I32Const(20)
+				a.Return()
+			})
+			a.I32Const(-1)
+		}))
+	b.ExportFunc("switch", f)
+	m := b.Module()
+
+	err, _ := validator.Validate(m)
+	require.NoError(t, err)
+	inst, err := interpreter.NewInstance(m, nil)
+	require.NoError(t, err)
+	for x, want := range []int32{10, 20, -1, -1} {
+		result, err := inst.CallFunc("switch", int32(x))
+		require.NoError(t, err)
+		require.Equal(t, want, result)
+	}
+}
@@ -0,0 +1,164 @@
+package asm
+
+import "github.com/zxh0/wasm.go/binary"
+
+// instructions without immediates
+
+func (a *Assembler) Unreachable()       { a.Op(binary.Unreachable) }
+func (a *Assembler) Nop()               { a.Op(binary.Nop) }
+func (a *Assembler) Return()            { a.Op(binary.Return) }
+func (a *Assembler) Drop()              { a.Op(binary.Drop) }
+func (a *Assembler) Select()            { a.Op(binary.Select) }
+func (a *Assembler) MemorySize()        { a.Op(binary.MemorySize) }
+func (a *Assembler) MemoryGrow()        { a.Op(binary.MemoryGrow) }
+func (a *Assembler) I32Eqz()            { a.Op(binary.I32Eqz) }
+func (a *Assembler) I32Eq()             { a.Op(binary.I32Eq) }
+func (a *Assembler) I32Ne()             { a.Op(binary.I32Ne) }
+func (a *Assembler) I32LtS()            { a.Op(binary.I32LtS) }
+func (a *Assembler) I32LtU()            { a.Op(binary.I32LtU) }
+func (a *Assembler) I32GtS()            { a.Op(binary.I32GtS) }
+func (a *Assembler) I32GtU()            { a.Op(binary.I32GtU) }
+func (a *Assembler) I32LeS()            { a.Op(binary.I32LeS) }
+func (a *Assembler) I32LeU()            { a.Op(binary.I32LeU) }
+func (a *Assembler) I32GeS()            { a.Op(binary.I32GeS) }
+func (a *Assembler) I32GeU()            { a.Op(binary.I32GeU) }
+func (a *Assembler) I64Eqz()            { a.Op(binary.I64Eqz) }
+func (a *Assembler) I64Eq()             { a.Op(binary.I64Eq) }
+func (a *Assembler) I64Ne()             { a.Op(binary.I64Ne) }
+func (a *Assembler) I64LtS()            { a.Op(binary.I64LtS) }
+func (a *Assembler) I64LtU()            { a.Op(binary.I64LtU) }
+func (a *Assembler) I64GtS()            { a.Op(binary.I64GtS) }
+func (a *Assembler) I64GtU()            { a.Op(binary.I64GtU) }
+func (a *Assembler) I64LeS()            { a.Op(binary.I64LeS) }
+func (a *Assembler) I64LeU()            { a.Op(binary.I64LeU) }
+func (a *Assembler) I64GeS()            { a.Op(binary.I64GeS) }
+func (a *Assembler) I64GeU()            { a.Op(binary.I64GeU) }
+func (a *Assembler) F32Eq()             { a.Op(binary.F32Eq) }
+func (a *Assembler) F32Ne()             { a.Op(binary.F32Ne) }
+func (a *Assembler) F32Lt()             { a.Op(binary.F32Lt) }
+func (a *Assembler) F32Gt()             { a.Op(binary.F32Gt) }
+func (a *Assembler) F32Le()             { a.Op(binary.F32Le) }
+func (a *Assembler) F32Ge()             { a.Op(binary.F32Ge) }
+func (a *Assembler) F64Eq()             { a.Op(binary.F64Eq) }
+func (a *Assembler) F64Ne()             { a.Op(binary.F64Ne) }
+func (a *Assembler) F64Lt()             { a.Op(binary.F64Lt) }
+func (a *Assembler) F64Gt()             { a.Op(binary.F64Gt) }
+func (a *Assembler) F64Le()             { a.Op(binary.F64Le) }
+func (a *Assembler) F64Ge()             { a.Op(binary.F64Ge) }
+func (a *Assembler) I32Clz()            { a.Op(binary.I32Clz) }
+func (a *Assembler) I32Ctz()            { a.Op(binary.I32Ctz) }
+func (a *Assembler) I32PopCnt()         { a.Op(binary.I32PopCnt) }
+func (a *Assembler) I32Add()            { a.Op(binary.I32Add) }
+func (a *Assembler) I32Sub()            { a.Op(binary.I32Sub) }
+func (a *Assembler) I32Mul()            { a.Op(binary.I32Mul) }
+func (a *Assembler) I32DivS()           { a.Op(binary.I32DivS) }
+func (a *Assembler) I32DivU()           { a.Op(binary.I32DivU) }
+func (a *Assembler) I32RemS()           { a.Op(binary.I32RemS) }
+func (a *Assembler) I32RemU()           { a.Op(binary.I32RemU) }
+func (a *Assembler) I32And()            { a.Op(binary.I32And) }
+func (a *Assembler) I32Or()             { a.Op(binary.I32Or) }
+func (a *Assembler) I32Xor()            { a.Op(binary.I32Xor) }
+func (a *Assembler) I32Shl()            { a.Op(binary.I32Shl) }
+func (a *Assembler) I32ShrS()           { a.Op(binary.I32ShrS) }
+func (a *Assembler) I32ShrU()           { a.Op(binary.I32ShrU) }
+func (a *Assembler) I32Rotl()           { a.Op(binary.I32Rotl) }
+func (a *Assembler) I32Rotr()           { a.Op(binary.I32Rotr) }
+func (a *Assembler) I64Clz()            { a.Op(binary.I64Clz) }
+func (a *Assembler) I64Ctz()            { a.Op(binary.I64Ctz) }
+func (a *Assembler) I64PopCnt()         { a.Op(binary.I64PopCnt) }
+func (a *Assembler) I64Add()            { a.Op(binary.I64Add) }
+func (a *Assembler) I64Sub()            { a.Op(binary.I64Sub) }
+func (a *Assembler) I64Mul()            { a.Op(binary.I64Mul) }
+func (a *Assembler) I64DivS()           { a.Op(binary.I64DivS) }
+func (a *Assembler) I64DivU()           { a.Op(binary.I64DivU) }
+func (a *Assembler) I64RemS()           { a.Op(binary.I64RemS) }
+func (a *Assembler) I64RemU()           { a.Op(binary.I64RemU) }
+func (a *Assembler) I64And()            { a.Op(binary.I64And) }
+func (a *Assembler) I64Or()             { a.Op(binary.I64Or) }
+func (a *Assembler) I64Xor()            { a.Op(binary.I64Xor) }
+func (a *Assembler) I64Shl()            { a.Op(binary.I64Shl) }
+func (a *Assembler) I64ShrS()           { a.Op(binary.I64ShrS) }
+func (a *Assembler) I64ShrU()           { a.Op(binary.I64ShrU) }
+func (a *Assembler) I64Rotl()           { a.Op(binary.I64Rotl) }
+func (a *Assembler) I64Rotr()           { a.Op(binary.I64Rotr) }
+func (a *Assembler) F32Abs()            { a.Op(binary.F32Abs) }
+func (a *Assembler) F32Neg()            { a.Op(binary.F32Neg) }
+func (a *Assembler) F32Ceil()           { a.Op(binary.F32Ceil) }
+func (a *Assembler) F32Floor()          { a.Op(binary.F32Floor) }
+func (a *Assembler) F32Trunc()          { a.Op(binary.F32Trunc) }
+func (a *Assembler) F32Nearest()        { a.Op(binary.F32Nearest) }
+func (a *Assembler) F32Sqrt()           { a.Op(binary.F32Sqrt) }
+func (a *Assembler) F32Add()            { a.Op(binary.F32Add) }
+func (a *Assembler) F32Sub()            { a.Op(binary.F32Sub) }
+func (a *Assembler) F32Mul()            { a.Op(binary.F32Mul) }
+func (a *Assembler) F32Div()            { a.Op(binary.F32Div) }
+func (a *Assembler) F32Min()            { a.Op(binary.F32Min) }
+func (a *Assembler) F32Max()            { a.Op(binary.F32Max) }
+func (a *Assembler) F32CopySign()       { a.Op(binary.F32CopySign) }
+func (a *Assembler) F64Abs()            { a.Op(binary.F64Abs) }
+func (a *Assembler) F64Neg()            { a.Op(binary.F64Neg) }
+func (a *Assembler) F64Ceil()           { a.Op(binary.F64Ceil) }
+func (a *Assembler) F64Floor()          { a.Op(binary.F64Floor) }
+func (a *Assembler) F64Trunc()          { a.Op(binary.F64Trunc) }
+func (a *Assembler) F64Nearest()        { a.Op(binary.F64Nearest) }
+func (a *Assembler) F64Sqrt()           { a.Op(binary.F64Sqrt) }
+func (a *Assembler) F64Add()            { a.Op(binary.F64Add) }
+func (a *Assembler) F64Sub()            { a.Op(binary.F64Sub) }
+func (a *Assembler) F64Mul()            { a.Op(binary.F64Mul) }
+func (a *Assembler) F64Div()            { a.Op(binary.F64Div) }
+func (a *Assembler) F64Min()            { a.Op(binary.F64Min) }
+func (a *Assembler) F64Max()            { a.Op(binary.F64Max) }
+func (a *Assembler) F64CopySign()       { a.Op(binary.F64CopySign) }
+func (a *Assembler) I32WrapI64()        { a.Op(binary.I32WrapI64) }
+func (a *Assembler) I32TruncF32S()      { a.Op(binary.I32TruncF32S) }
+func (a *Assembler) I32TruncF32U()      { a.Op(binary.I32TruncF32U) }
+func (a *Assembler) I32TruncF64S()      { a.Op(binary.I32TruncF64S) }
+func (a *Assembler) I32TruncF64U()      { a.Op(binary.I32TruncF64U) }
+func (a *Assembler) I64ExtendI32S()     { a.Op(binary.I64ExtendI32S) }
+func (a *Assembler) I64ExtendI32U()     { a.Op(binary.I64ExtendI32U) }
+func (a *Assembler) I64TruncF32S()      { a.Op(binary.I64TruncF32S) }
+func (a *Assembler) I64TruncF32U()      { a.Op(binary.I64TruncF32U) }
+func (a *Assembler) I64TruncF64S()      { a.Op(binary.I64TruncF64S) }
+func (a *Assembler) I64TruncF64U()      { a.Op(binary.I64TruncF64U) }
+func (a *Assembler) F32ConvertI32S()    { a.Op(binary.F32ConvertI32S) }
+func (a *Assembler) F32ConvertI32U()    { a.Op(binary.F32ConvertI32U) }
+func (a *Assembler) F32ConvertI64S()    { a.Op(binary.F32ConvertI64S) }
+func (a *Assembler) F32ConvertI64U()    { a.Op(binary.F32ConvertI64U) }
+func (a *Assembler) F32DemoteF64()      { a.Op(binary.F32DemoteF64) }
+func (a *Assembler) F64ConvertI32S()    { a.Op(binary.F64ConvertI32S) }
+func (a *Assembler) F64ConvertI32U()    { a.Op(binary.F64ConvertI32U) }
+func (a *Assembler) F64ConvertI64S()    { a.Op(binary.F64ConvertI64S) }
+func (a *Assembler) F64ConvertI64U()    { a.Op(binary.F64ConvertI64U) }
+func (a *Assembler) F64PromoteF32()     { a.Op(binary.F64PromoteF32) }
+func (a *Assembler) I32ReinterpretF32() { a.Op(binary.I32ReinterpretF32) }
+func (a *Assembler) I64ReinterpretF64() { a.Op(binary.I64ReinterpretF64) }
+func (a *Assembler) F32ReinterpretI32() { a.Op(binary.F32ReinterpretI32) }
+func (a *Assembler) F64ReinterpretI64() { a.Op(binary.F64ReinterpretI64) }
+func (a *Assembler) RefIsNull()         { a.Op(binary.RefIsNull) }
+func (a *Assembler) RefAsNonNull()      { a.Op(binary.RefAsNonNull) }
+
+// memory instructions
+
+func (a *Assembler) I32Load(m binary.MemArg)    { a.emit(binary.I32Load, m) }
+func (a *Assembler) I64Load(m binary.MemArg)    { a.emit(binary.I64Load, m) }
+func (a *Assembler) F32Load(m binary.MemArg)    { a.emit(binary.F32Load, m) }
+func (a *Assembler) F64Load(m binary.MemArg)    { a.emit(binary.F64Load, m) }
+func (a *Assembler) I32Load8S(m binary.MemArg)  { a.emit(binary.I32Load8S, m) }
+func (a *Assembler) I32Load8U(m binary.MemArg)  { a.emit(binary.I32Load8U, m) }
+func (a *Assembler) I32Load16S(m binary.MemArg) { a.emit(binary.I32Load16S, m) }
+func (a *Assembler) I32Load16U(m binary.MemArg) { a.emit(binary.I32Load16U, m) }
+func (a *Assembler) I64Load8S(m binary.MemArg)  { a.emit(binary.I64Load8S, m) }
+func (a *Assembler) I64Load8U(m binary.MemArg)  { a.emit(binary.I64Load8U, m) }
+func (a *Assembler) I64Load16S(m binary.MemArg) { a.emit(binary.I64Load16S, m) }
+func (a *Assembler) I64Load16U(m binary.MemArg) { a.emit(binary.I64Load16U, m) }
+func (a *Assembler) I64Load32S(m binary.MemArg) { a.emit(binary.I64Load32S, m) }
+func (a *Assembler) I64Load32U(m binary.MemArg) { a.emit(binary.I64Load32U, m) }
+func (a *Assembler) I32Store(m binary.MemArg)   { a.emit(binary.I32Store, m) }
+func (a *Assembler) I64Store(m binary.MemArg)   { a.emit(binary.I64Store, m) }
+func (a *Assembler) F32Store(m binary.MemArg)   { a.emit(binary.F32Store, m) }
+func (a *Assembler) F64Store(m binary.MemArg)   { a.emit(binary.F64Store, m) }
+func (a *Assembler) I32Store8(m binary.MemArg)  { a.emit(binary.I32Store8, m) }
+func (a *Assembler) I32Store16(m binary.MemArg) { a.emit(binary.I32Store16, m) }
+func (a *Assembler) I64Store8(m binary.MemArg)  { a.emit(binary.I64Store8, m) }
+func (a *Assembler) I64Store16(m binary.MemArg) { a.emit(binary.I64Store16, m) }
+func (a *Assembler) I64Store32(m binary.MemArg) { a.emit(binary.I64Store32, m) }
@@ -1,5 +1,7 @@
 package binary
 
+import "unsafe"
+
 type (
 	TypeIdx   = uint32
 	FuncIdx   = uint32
@@ -11,7 +13,7 @@ type (
 )
 
 func readIndices(reader *WasmReader) (vec []uint32, err error) {
-	n, err := reader.readVarU32()
+	n, err := reader.readVecLen(unsafe.Sizeof(uint32(0)))
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,215 @@
+package binary
+
+import "fmt"
+
+// Builder assembles a Module, deduplicating function types and
+// keeping track of the index spaces.
+// Imports must be added before the definitions of the same kind,
+// otherwise the returned indices would be invalid.
+type Builder struct {
+	module   Module
+	ftyBySig map[string]TypeIdx
+	funcs    uint32 // imported + defined
+	tables   uint32
+	mems     uint32
+	globals  uint32
+}
+
+func NewBuilder() *Builder {
+	return &Builder{
+		module:   Module{Magic: MagicNumber, Version: Version},
+		ftyBySig: map[string]TypeIdx{},
+	}
+}
+
+// Module returns the module built so far.
+func (b *Builder) Module() Module {
+	return b.module
+}
+
+// AddType returns the index of ft, adding it if there is no equal type yet.
+func (b *Builder) AddType(ft FuncType) TypeIdx {
+	if idx, found := b.ftyBySig[ft.GetSignature()]; found {
+		return idx
+	}
+	return b.DefineType(ft)
+}
+
+// DefineType adds ft even if there is an equal type already,
+// AddType returns the first of equal types.
+func (b *Builder) DefineType(ft FuncType) TypeIdx {
+	idx := TypeIdx(len(b.module.TypeSec))
+	b.module.TypeSec = append(b.module.TypeSec, ft)
+	sig := ft.GetSignature()
+	if _, found := b.ftyBySig[sig]; !found {
+		b.ftyBySig[sig] = idx
+	}
+	return idx
+}
+
+// ImportFunc adds the type of the function only if it can be imported.
+func (b *Builder) ImportFunc(module, name string, ft FuncType) (FuncIdx, error) {
+	if _, err := b.importCount(ImportTagFunc); err != nil {
+		return 0, err
+	}
+	return b.AddImport(Import{Module: module, Name: name, Desc: ImportDesc{
+		Tag:      ImportTagFunc,
+		FuncType: b.AddType(ft),
+	}})
+}
+
+func (b *Builder) ImportTable(module, name string, tt TableType) (TableIdx, error) {
+	return b.AddImport(Import{Module: module, Name: name, Desc: ImportDesc{
+		Tag:   ImportTagTable,
+		Table: tt,
+	}})
+}
+
+func (b *Builder) ImportMem(module, name string, mt MemType) (MemIdx, error) {
+	return b.AddImport(Import{Module: module, Name: name, Desc: ImportDesc{
+		Tag: ImportTagMem,
+		Mem: mt,
+	}})
+}
+
+func (b *Builder) ImportGlobal(module, name string, gt GlobalType) (GlobalIdx, error) {
+	return b.AddImport(Import{Module: module, Name: name, Desc: ImportDesc{
+		Tag:    ImportTagGlobal,
+		Global: gt,
+	}})
+}
+
+// AddImport adds imp and returns its index in the index space of its kind.
+// The type of an imported function must have been added already.
+func (b *Builder) AddImport(imp Import) (uint32, error) {
+	n, err := b.importCount(imp.Desc.Tag)
+	if err != nil {
+		return 0, err
+	}
+	b.module.ImportSec = append(b.module.ImportSec, imp)
+	*n++
+	return *n - 1, nil
+}
+
+// returns the size of the index space an import of kind tag is added to,
+// imports must precede the definitions of their kind
+func (b *Builder) importCount(tag byte) (*uint32, error) {
+	switch tag {
+	case ImportTagFunc:
+		if len(b.module.FuncSec) > 0 {
+			return nil, fmt.Errorf("func imports must be added before funcs")
+		}
+		return &b.funcs, nil
+	case ImportTagTable:
+		if len(b.module.TableSec) > 0 {
+			return nil, fmt.Errorf("table imports must be added before tables")
+		}
+		return &b.tables, nil
+	case ImportTagMem:
+		if len(b.module.MemSec) > 0 {
+			return nil, fmt.Errorf("memory imports must be added before memories")
+		}
+		return &b.mems, nil
+	case ImportTagGlobal:
+		if len(b.module.GlobalSec) > 0 {
+			return nil, fmt.Errorf("global imports must be added before globals")
+		}
+		return &b.globals, nil
+	default:
+		return nil, fmt.Errorf("invalid import tag: %d", tag)
+	}
+}
+
+// AddFunc adds a function, adjacent locals of the same type are grouped.
+func (b *Builder) AddFunc(sig FuncType, locals []ValType, body Expr) FuncIdx {
+	return b.AddFuncOfType(b.AddType(sig), groupLocals(locals), body)
+}
+
+// AddFuncOfType adds a function whose type has been added already.
+func (b *Builder) AddFuncOfType(ftIdx TypeIdx, locals []Locals, body Expr) FuncIdx {
+	b.module.FuncSec = append(b.module.FuncSec, ftIdx)
+	b.module.CodeSec = append(b.module.CodeSec, Code{
+		Locals: locals,
+		Expr:   body,
+	})
+	b.funcs++
+	return b.funcs - 1
+}
+
+func groupLocals(locals []ValType) []Locals {
+	var vec []Locals
+	for _, vt := range locals {
+		if n := len(vec); n > 0 && vec[n-1].Type == vt {
+			vec[n-1].N++
+		} else {
+			vec = append(vec, Locals{N: 1, Type: vt})
+		}
+	}
+	return vec
+}
+
+func (b *Builder) AddTable(tt TableType) TableIdx {
+	b.module.TableSec = append(b.module.TableSec, tt)
+	b.tables++
+	return b.tables - 1
+}
+
+func (b *Builder) AddMem(mt MemType) MemIdx {
+	b.module.MemSec = append(b.module.MemSec, mt)
+	b.mems++
+	return b.mems - 1
+}
+
+func (b *Builder) AddGlobal(gt GlobalType, init Expr) GlobalIdx {
+	b.module.GlobalSec = append(b.module.GlobalSec,
+		Global{Type: gt, Expr: init})
+	b.globals++
+	return b.globals - 1
+}
+
+func (b *Builder) ExportFunc(name string, idx FuncIdx) {
+	b.AddExport(name, ExportTagFunc, idx)
+}
+func (b *Builder) ExportTable(name string, idx TableIdx) {
+	b.AddExport(name, ExportTagTable, idx)
+}
+func (b *Builder) ExportMem(name string, idx MemIdx) {
+	b.AddExport(name, ExportTagMem, idx)
+}
+func (b *Builder) ExportGlobal(name string, idx GlobalIdx) {
+	b.AddExport(name, ExportTagGlobal, idx)
+}
+
+func (b *Builder) AddExport(name string, tag byte, idx uint32) {
+	b.module.ExportSec = append(b.module.ExportSec,
+		Export{Name: name, Desc: ExportDesc{Tag: tag, Idx: idx}})
+}
+
+func (b *Builder) SetStart(idx FuncIdx) {
+	b.module.StartSec = &idx
+}
+
+func (b *Builder) AddElem(table TableIdx, offset Expr, funcs []FuncIdx) {
+	b.module.ElemSec = append(b.module.ElemSec,
+		Elem{Table: table, Offset: offset, Init: funcs})
+}
+
+// adds a declarative segment of funcs
+func (b *Builder) DeclareElem(funcs []FuncIdx) {
+	b.module.ElemSec = append(b.module.ElemSec,
+		Elem{Flag: ElemFlagDeclarative, Init: funcs})
+}
+
+func (b *Builder) AddData(mem MemIdx, offset Expr, data []byte) {
+	b.module.DataSec = append(b.module.DataSec,
+		Data{Mem: mem, Offset: offset, Init: data})
+}
+
+func (b *Builder) AddCustom(name string, data []byte) {
+	b.AddCustomSec(CustomSec{Name: name, Data: data})
+}
+
+// AddCustomSec adds a custom section with its placement.
+func (b *Builder) AddCustomSec(sec CustomSec) {
+	b.module.CustomSecs = append(b.module.CustomSecs, sec)
+}
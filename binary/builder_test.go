@@ -0,0 +1,62 @@
+package binary
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuilder(t *testing.T) {
+	v2i := FuncType{ResultTypes: []ValType{ValTypeI32}}
+	i2i := FuncType{
+		ParamTypes:  []ValType{ValTypeI32},
+		ResultTypes: []ValType{ValTypeI32},
+	}
+
+	b := NewBuilder()
+	idx, err := b.ImportFunc("env", "f", i2i)
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), idx)
+	idx, err = b.ImportGlobal("env", "g", GlobalType{ValType: ValTypeI64})
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), idx)
+	require.Equal(t, uint32(1), b.AddFunc(v2i, nil, Expr{
+		{Opcode: I32Const, Args: int32(1)},
+	}))
+	require.Equal(t, uint32(2), b.AddFunc(i2i,
+		[]ValType{ValTypeI32, ValTypeI32, ValTypeI64, ValTypeI32},
+		Expr{{Opcode: LocalGet, Args: uint32(0)}}))
+	require.Equal(t, uint32(1), b.AddGlobal(GlobalType{ValType: ValTypeI32},
+		Expr{{Opcode: I32Const, Args: int32(0)}}))
+	require.Equal(t, uint32(0), b.AddMem(MemType{Min: 1}))
+	b.ExportFunc("one", 1)
+	b.SetStart(1)
+	b.AddData(0, Expr{{Opcode: I32Const, Args: int32(8)}}, []byte("hi"))
+
+	m := b.Module()
+	require.Equal(t, uint32(MagicNumber), m.Magic)
+	require.Equal(t, []FuncType{i2i, v2i}, m.TypeSec)
+	require.Equal(t, []TypeIdx{1, 0}, m.FuncSec)
+	require.Equal(t, []Locals{
+		{N: 2, Type: ValTypeI32},
+		{N: 1, Type: ValTypeI64},
+		{N: 1, Type: ValTypeI32},
+	}, m.CodeSec[1].Locals)
+	require.Equal(t, 4, m.CodeSec[1].GetLocalCount())
+	require.Equal(t, uint32(1), *m.StartSec)
+	require.Equal(t, "one", m.ExportSec[0].Name)
+
+	typeCount := len(b.Module().TypeSec)
+	_, err = b.ImportFunc("env", "h", FuncType{ParamTypes: []ValType{ValTypeF64}})
+	require.EqualError(t, err, "func imports must be added before funcs")
+	require.Len(t, b.Module().TypeSec, typeCount) // no orphan type
+	_, err = b.ImportGlobal("env", "h", GlobalType{ValType: ValTypeI32})
+	require.EqualError(t, err, "global imports must be added before globals")
+	idx, err = b.ImportTable("env", "t", TableType{ElemType: FuncRef})
+	require.NoError(t, err)
+	require.Equal(t, uint32(0), idx)
+	require.Len(t, b.Module().ImportSec, 3)
+
+	require.Equal(t, TypeIdx(2), b.DefineType(v2i))
+	require.Equal(t, TypeIdx(1), b.AddType(v2i))
+}
@@ -0,0 +1,309 @@
+package binary
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"io/ioutil"
+)
+
+// Decoder reads a module from an io.Reader section by section,
+// and reports what it reads through callbacks.
+// Sections without callbacks are skipped without being decoded.
+type Decoder struct {
+	r        *bufio.Reader
+	pos      int // offset in module
+	limiter  *limiter
+	funcs    uint32 // count of the func section
+	codes    uint32 // count of the code section
+	Features Features
+	Limits   DecodeLimits
+
+	OnSection  func(sec Section) error
+	OnCustom   func(name string, data []byte) error
+	OnType     func(ft FuncType) error
+	OnImport   func(imp Import) error
+	OnFunc     func(ftIdx TypeIdx) error
+	OnTable    func(tt TableType) error
+	OnMem      func(mt MemType) error
+	OnGlobal   func(g Global) error
+	OnExport   func(exp Export) error
+	OnStart    func(fIdx FuncIdx) error
+	OnElem     func(elem Elem) error
+	OnFuncBody func(idx uint32, code Code) error // idx excludes imported funcs
+	OnData     func(data Data) error
+}
+
+func NewDecoder(r io.Reader) *Decoder {
+	return &Decoder{
+		r:        bufio.NewReader(r),
+		Features: DefaultFeatures,
+	}
+}
+
+func (d *Decoder) Decode() error {
+	var header [8]byte
+	if _, err := io.ReadFull(d.r, header[:]); err != nil {
+		return err
+	}
+	if magic := binary.LittleEndian.Uint32(header[:4]); magic != MagicNumber {
+		return fmt.Errorf("invalid magic number: 0x%x", magic)
+	}
+	if version := binary.LittleEndian.Uint32(header[4:]); version != Version {
+		return fmt.Errorf("unsupported version: %d", version)
+	}
+	d.pos = len(header)
+	d.limiter = newLimiter(d.Limits)
+
+	lastSecID := byte(0)
+	for {
+		secID, err := d.r.ReadByte()
+		if err == io.EOF {
+			if d.codes != d.funcs {
+				return errInconsistentCodeCount
+			}
+			return nil
+		} else if err != nil {
+			return err
+		}
+		d.pos++
+		if secID > SecCustomID {
+			if secID <= lastSecID {
+				return fmt.Errorf("invalid sec ID: %d", secID)
+			}
+			lastSecID = secID
+		}
+
+		size, err := d.readVarU32()
+		if err != nil {
+			return err
+		}
+		if err := d.limiter.checkSectionSize(size); err != nil {
+			return err
+		}
+		if d.OnSection != nil {
+			sec := Section{ID: secID, Offset: uint32(d.pos), Size: size}
+			if err := d.OnSection(sec); err != nil {
+				return err
+			}
+		}
+		if err := d.decodeSec(secID, size); err != nil {
+			return err
+		}
+	}
+}
+
+func (d *Decoder) decodeSec(secID byte, size uint32) error {
+	// imported and defined funcs are counted against the limits
+	// even if they are not reported
+	if !d.hasCallback(secID) && secID != SecImportID &&
+		secID != SecFuncID && secID != SecCodeID {
+		n, err := io.CopyN(ioutil.Discard, d.r, int64(size))
+		d.pos += int(n)
+		return err
+	}
+	if secID == SecCodeID {
+		return d.decodeCodeSec(size)
+	}
+
+	cont, err := d.readN(size)
+	if err != nil {
+		return err
+	}
+	reader := &WasmReader{
+		data:     cont,
+		features: d.Features,
+		end:      d.pos,
+		limiter:  d.limiter,
+	}
+	if secID == SecCustomID {
+		name, err := reader.readName()
+		if err != nil {
+			return err
+		}
+		return d.OnCustom(name, reader.data)
+	}
+
+	var m Module
+	if err := readNonCustomSec(secID, reader, &m); err != nil {
+		return err
+	}
+	if reader.remaining() > 0 {
+		return fmt.Errorf("invalid sec, id=%d", secID)
+	}
+	if secID == SecFuncID {
+		d.funcs = uint32(len(m.FuncSec))
+	}
+	if !d.hasCallback(secID) {
+		return nil
+	}
+	return d.emit(secID, &m)
+}
+
+// function bodies are read one at a time
+func (d *Decoder) decodeCodeSec(size uint32) error {
+	lr := &io.LimitedReader{R: d.r, N: int64(size)}
+	br := bufio.NewReader(lr)
+	n, w, err := readVarU32From(br)
+	if err != nil {
+		return err
+	}
+	if err := d.limiter.checkFuncs(n); err != nil {
+		return err
+	}
+	if d.codes = n; n != d.funcs {
+		return errInconsistentCodeCount
+	}
+	pos := d.pos + w
+	d.pos += int(size)
+	if d.OnFuncBody == nil {
+		_, err := io.Copy(ioutil.Discard, br)
+		return err
+	}
+	for i := uint32(0); i < n; i++ {
+		bodySize, w, err := readVarU32From(br)
+		if err != nil {
+			return err
+		}
+		if int64(bodySize) > lr.N+int64(br.Buffered()) {
+			return fmt.Errorf("function body out of bounds: %d", bodySize)
+		}
+		if err := d.limiter.alloc(uint64(bodySize)); err != nil {
+			return err
+		}
+		body := make([]byte, bodySize)
+		if _, err := io.ReadFull(br, body); err != nil {
+			return err
+		}
+		pos += w + len(body)
+		reader := &WasmReader{
+			data:     body,
+			features: d.Features,
+			end:      pos,
+			limiter:  d.limiter,
+		}
+		code, err := decodeCode(reader)
+		if err != nil {
+			return err
+		}
+		if err := d.OnFuncBody(i, code); err != nil {
+			return err
+		}
+	}
+	if lr.N > 0 || br.Buffered() > 0 {
+		return fmt.Errorf("invalid sec, id=%d", SecCodeID)
+	}
+	return nil
+}
+
+func (d *Decoder) hasCallback(secID byte) bool {
+	switch secID {
+	case SecCustomID:
+		return d.OnCustom != nil
+	case SecTypeID:
+		return d.OnType != nil
+	case SecImportID:
+		return d.OnImport != nil
+	case SecFuncID:
+		return d.OnFunc != nil
+	case SecTableID:
+		return d.OnTable != nil
+	case SecMemID:
+		return d.OnMem != nil
+	case SecGlobalID:
+		return d.OnGlobal != nil
+	case SecExportID:
+		return d.OnExport != nil
+	case SecStartID:
+		return d.OnStart != nil
+	case SecElemID:
+		return d.OnElem != nil
+	case SecCodeID:
+		return d.OnFuncBody != nil
+	case SecDataID:
+		return d.OnData != nil
+	default:
+		return false
+	}
+}
+
+func (d *Decoder) emit(secID byte, m *Module) (err error) {
+	switch secID {
+	case SecTypeID:
+		for i := 0; i < len(m.TypeSec) && err == nil; i++ {
+			err = d.OnType(m.TypeSec[i])
+		}
+	case SecImportID:
+		for i := 0; i < len(m.ImportSec) && err == nil; i++ {
+			err = d.OnImport(m.ImportSec[i])
+		}
+	case SecFuncID:
+		for i := 0; i < len(m.FuncSec) && err == nil; i++ {
+			err = d.OnFunc(m.FuncSec[i])
+		}
+	case SecTableID:
+		for i := 0; i < len(m.TableSec) && err == nil; i++ {
+			err = d.OnTable(m.TableSec[i])
+		}
+	case SecMemID:
+		for i := 0; i < len(m.MemSec) && err == nil; i++ {
+			err = d.OnMem(m.MemSec[i])
+		}
+	case SecGlobalID:
+		for i := 0; i < len(m.GlobalSec) && err == nil; i++ {
+			err = d.OnGlobal(m.GlobalSec[i])
+		}
+	case SecExportID:
+		for i := 0; i < len(m.ExportSec) && err == nil; i++ {
+			err = d.OnExport(m.ExportSec[i])
+		}
+	case SecStartID:
+		err = d.OnStart(*m.StartSec)
+	case SecElemID:
+		for i := 0; i < len(m.ElemSec) && err == nil; i++ {
+			err = d.OnElem(m.ElemSec[i])
+		}
+	case SecDataID:
+		for i := 0; i < len(m.DataSec) && err == nil; i++ {
+			err = d.OnData(m.DataSec[i])
+		}
+	}
+	return
+}
+
+func (d *Decoder) readN(n uint32) ([]byte, error) {
+	if err := d.limiter.alloc(uint64(n)); err != nil {
+		return nil, err
+	}
+	buf := make([]byte, n)
+	_, err := io.ReadFull(d.r, buf)
+	d.pos += len(buf)
+	return buf, err
+}
+
+func (d *Decoder) readVarU32() (uint32, error) {
+	n, w, err := readVarU32From(d.r)
+	d.pos += w
+	return n, err
+}
+
+// returns the value and its width
+func readVarU32From(r io.ByteReader) (uint32, int, error) {
+	buf := make([]byte, 0, 5)
+	for {
+		b, err := r.ReadByte()
+		if err != nil {
+			return 0, len(buf), err
+		}
+		buf = append(buf, b)
+		if b&0x80 == 0 || len(buf) == 5 {
+			break
+		}
+	}
+	n, w := readVarUint(buf, 32)
+	if w <= 0 {
+		return 0, len(buf), fmt.Errorf("LEB128 error")
+	}
+	return uint32(n), w, nil
+}
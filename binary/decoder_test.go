@@ -0,0 +1,92 @@
+package binary
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecoder(t *testing.T) {
+	f, err := os.Open("./testdata/hw_rust.wasm")
+	require.NoError(t, err)
+	defer f.Close()
+
+	var secIDs []byte
+	var customNames []string
+	typeCount, exportCount, bodyCount := 0, 0, 0
+
+	d := NewDecoder(f)
+	d.OnSection = func(sec Section) error {
+		secIDs = append(secIDs, sec.ID)
+		return nil
+	}
+	d.OnCustom = func(name string, data []byte) error {
+		customNames = append(customNames, name)
+		return nil
+	}
+	d.OnType = func(ft FuncType) error { typeCount++; return nil }
+	d.OnExport = func(exp Export) error { exportCount++; return nil }
+	d.OnFuncBody = func(idx uint32, code Code) error {
+		require.Equal(t, uint32(bodyCount), idx)
+		bodyCount++
+		return nil
+	}
+	require.NoError(t, d.Decode())
+
+	require.Equal(t, 2, len(customNames))
+	require.Equal(t, 15, typeCount)
+	require.Equal(t, 5, exportCount)
+	require.Equal(t, 171, bodyCount)
+	require.Contains(t, secIDs, byte(SecCodeID))
+}
+
+func TestDecoderStop(t *testing.T) {
+	f, err := os.Open("./testdata/hw_rust.wasm")
+	require.NoError(t, err)
+	defer f.Close()
+
+	stop := errors.New("stop")
+	bodyCount := 0
+	d := NewDecoder(f)
+	d.OnFuncBody = func(idx uint32, code Code) error {
+		if bodyCount++; bodyCount == 3 {
+			return stop
+		}
+		return nil
+	}
+	require.Equal(t, stop, d.Decode())
+	require.Equal(t, 3, bodyCount)
+}
+
+func TestDecodeMalformedSections(t *testing.T) {
+	typeSec := section(SecTypeID, 1, 0x60, 0, 0) // () -> ()
+	funcSec := section(SecFuncID, 2, 0, 0)       // 2 funcs
+	codeSec := section(SecCodeID, 1, 2, 0, _End) // 1 empty body
+
+	decode := func(secs ...[]byte) (error, error) {
+		data := wasmModule(secs...)
+		_, err := Decode(data)
+		d := NewDecoder(bytes.NewReader(data))
+		d.OnFuncBody = func(idx uint32, code Code) error { return nil }
+		return err, d.Decode()
+	}
+
+	err1, err2 := decode(typeSec, typeSec)
+	require.EqualError(t, err1, "invalid sec ID: 1")
+	require.EqualError(t, err2, "invalid sec ID: 1")
+
+	err1, err2 = decode(typeSec, funcSec, codeSec)
+	require.Equal(t, errInconsistentCodeCount, err1)
+	require.Equal(t, errInconsistentCodeCount, err2)
+
+	err1, err2 = decode(typeSec, funcSec) // no code section
+	require.Equal(t, errInconsistentCodeCount, err1)
+	require.Equal(t, errInconsistentCodeCount, err2)
+
+	// the count is checked even if bodies are not reported
+	d := NewDecoder(bytes.NewReader(wasmModule(typeSec, funcSec, codeSec)))
+	require.Equal(t, errInconsistentCodeCount, d.Decode())
+}
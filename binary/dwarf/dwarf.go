@@ -0,0 +1,182 @@
+// Package dwarf decodes the DWARF sections (.debug_info, .debug_line, ...)
+// carried by custom sections of a module, and maps code offsets to
+// source locations.
+package dwarf
+
+import (
+	"debug/dwarf"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/zxh0/wasm.go/binary"
+)
+
+var ErrNoDebugInfo = errors.New("no debug info")
+
+type Data struct {
+	*dwarf.Data
+	codeOffset uint32 // DWARF addresses are relative to code section
+	units      []unit
+}
+
+type unit struct {
+	entry  *dwarf.Entry
+	ranges [][2]uint64
+	funcs  []function
+}
+
+type function struct {
+	name   string
+	ranges [][2]uint64
+}
+
+type Location struct {
+	File string
+	Line int
+	Func string
+}
+
+// e.g. src/lib.rs:42 in parse_header
+func (loc Location) String() string {
+	s := loc.File
+	if loc.Line > 0 {
+		s += fmt.Sprintf(":%d", loc.Line)
+	}
+	if loc.Func != "" {
+		if s != "" {
+			s += " "
+		}
+		s += "in " + loc.Func
+	}
+	return s
+}
+
+func New(m binary.Module) (*Data, error) {
+	secs := map[string][]byte{}
+	for _, cs := range m.CustomSecs {
+		if strings.HasPrefix(cs.Name, ".debug_") {
+			secs[cs.Name] = cs.Data
+		}
+	}
+	if secs[".debug_info"] == nil {
+		return nil, ErrNoDebugInfo
+	}
+
+	dd, err := dwarf.New(secs[".debug_abbrev"], secs[".debug_aranges"],
+		secs[".debug_frame"], secs[".debug_info"], secs[".debug_line"],
+		secs[".debug_pubnames"], secs[".debug_ranges"], secs[".debug_str"])
+	if err != nil {
+		return nil, err
+	}
+	// DWARF 5
+	for _, name := range []string{".debug_addr", ".debug_line_str",
+		".debug_str_offsets", ".debug_rnglists", ".debug_loclists"} {
+		if data := secs[name]; data != nil {
+			if err := dd.AddSection(name, data); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	d := &Data{Data: dd}
+	for _, sec := range m.Sections {
+		if sec.ID == binary.SecCodeID {
+			d.codeOffset = sec.Offset
+		}
+	}
+	if err := d.index(); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+func (d *Data) index() error {
+	r := d.Reader()
+	for {
+		entry, err := r.Next()
+		if err != nil {
+			return err
+		}
+		if entry == nil {
+			return nil
+		}
+
+		switch entry.Tag {
+		case dwarf.TagCompileUnit:
+			ranges, err := d.Ranges(entry)
+			if err != nil {
+				return err
+			}
+			d.units = append(d.units, unit{entry: entry, ranges: ranges})
+		case dwarf.TagSubprogram:
+			if len(d.units) == 0 {
+				continue
+			}
+			ranges, err := d.Ranges(entry)
+			if err != nil || len(ranges) == 0 {
+				continue
+			}
+			u := &d.units[len(d.units)-1]
+			u.funcs = append(u.funcs, function{
+				name:   d.funcName(entry),
+				ranges: ranges,
+			})
+		}
+	}
+}
+
+func (d *Data) funcName(entry *dwarf.Entry) string {
+	for i := 0; i < 8 && entry != nil; i++ { // follow at most 8 links
+		if name, ok := entry.Val(dwarf.AttrName).(string); ok {
+			return name
+		}
+		off, ok := entry.Val(dwarf.AttrAbstractOrigin).(dwarf.Offset)
+		if !ok {
+			off, ok = entry.Val(dwarf.AttrSpecification).(dwarf.Offset)
+		}
+		if !ok {
+			return ""
+		}
+		r := d.Reader()
+		r.Seek(off)
+		entry, _ = r.Next()
+	}
+	return ""
+}
+
+// maps offset in module (e.g. Instruction.Offset) to source location
+func (d *Data) Lookup(offset uint32) (loc Location, ok bool) {
+	if offset < d.codeOffset {
+		return
+	}
+	pc := uint64(offset - d.codeOffset)
+	for _, u := range d.units {
+		if !inRanges(u.ranges, pc) {
+			continue
+		}
+		if lr, err := d.LineReader(u.entry); err == nil && lr != nil {
+			var le dwarf.LineEntry
+			if lr.SeekPC(pc, &le) == nil {
+				loc.File = le.File.Name
+				loc.Line = le.Line
+			}
+		}
+		for _, f := range u.funcs {
+			if inRanges(f.ranges, pc) {
+				loc.Func = f.name // nested funcs come later
+			}
+		}
+		return loc, loc.File != "" || loc.Func != ""
+	}
+	return
+}
+
+func inRanges(ranges [][2]uint64, pc uint64) bool {
+	for _, r := range ranges {
+		if pc >= r[0] && pc < r[1] {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,47 @@
+package dwarf
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zxh0/wasm.go/binary"
+)
+
+// testdata/lib.wasm is built from testdata/lib.rs:
+//
+//	rustc +nightly --target wasm32-unknown-unknown --crate-type cdylib \
+//	  -g -C opt-level=0 -C panic=abort --remap-path-prefix=$PWD=src lib.rs
+func TestLookup(t *testing.T) {
+	m, err := binary.DecodeFile("./testdata/lib.wasm")
+	require.NoError(t, err)
+	d, err := New(m)
+	require.NoError(t, err)
+
+	loc, ok := d.Lookup(findCall(m.CodeSec[0]).Offset)
+	require.True(t, ok)
+	require.Equal(t, "src/lib.rs", loc.File)
+	require.Equal(t, 22, loc.Line)
+	require.Equal(t, "parse_header", loc.Func)
+	require.Equal(t, "src/lib.rs:22 in parse_header", loc.String())
+
+	loc, ok = d.Lookup(findCall(m.CodeSec[1]).Offset)
+	require.True(t, ok)
+	require.Equal(t, "src/lib.rs:28 in run", loc.String())
+
+	_, ok = d.Lookup(0)
+	require.False(t, ok)
+}
+
+func TestNoDebugInfo(t *testing.T) {
+	_, err := New(binary.Module{})
+	require.Equal(t, ErrNoDebugInfo, err)
+}
+
+func findCall(code binary.Code) binary.Instruction {
+	for _, instr := range code.Expr {
+		if instr.Opcode == binary.Call {
+			return instr
+		}
+	}
+	panic("call not found")
+}
@@ -0,0 +1,29 @@
+#![feature(no_core, lang_items)]
+#![allow(internal_features)]
+#![no_core]
+#![no_std]
+
+#[lang = "pointee_sized"]
+pub trait PointeeSized {}
+#[lang = "meta_sized"]
+pub trait MetaSized: PointeeSized {}
+#[lang = "sized"]
+pub trait Sized: MetaSized {}
+#[lang = "copy"]
+pub trait Copy {}
+impl Copy for i32 {}
+
+extern "C" {
+    fn check(x: i32) -> i32;
+}
+
+#[no_mangle]
+pub extern "C" fn parse_header(x: i32) -> i32 {
+    let y = unsafe { check(x) };
+    y
+}
+
+#[no_mangle]
+pub extern "C" fn run(x: i32) -> i32 {
+    parse_header(x)
+}
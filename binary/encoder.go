@@ -0,0 +1,281 @@
+package binary
+
+import "fmt"
+
+// Encode returns the binary format of module.
+// Custom sections are placed as their After fields say.
+func Encode(module Module) []byte {
+	writer := &wasmWriter{}
+	writer.writeU32(MagicNumber)
+	writer.writeU32(Version)
+	writeSec := func(secID byte, n int, f func(w *wasmWriter)) {
+		writer.writeSec(secID, n, f)
+		writer.writeCustomSecs(module.CustomSecs, secID)
+	}
+
+	writer.writeCustomSecs(module.CustomSecs, CustomSecFirst)
+	writeSec(SecTypeID, len(module.TypeSec), func(w *wasmWriter) {
+		for _, ft := range module.TypeSec {
+			writeFuncType(w, ft)
+		}
+	})
+	writeSec(SecImportID, len(module.ImportSec), func(w *wasmWriter) {
+		for _, imp := range module.ImportSec {
+			writeImport(w, imp)
+		}
+	})
+	writeSec(SecFuncID, len(module.FuncSec), func(w *wasmWriter) {
+		for _, ftIdx := range module.FuncSec {
+			w.writeVarU32(ftIdx)
+		}
+	})
+	writeSec(SecTableID, len(module.TableSec), func(w *wasmWriter) {
+		for _, tt := range module.TableSec {
+			writeTableType(w, tt)
+		}
+	})
+	writeSec(SecMemID, len(module.MemSec), func(w *wasmWriter) {
+		for _, mt := range module.MemSec {
+			writeLimits(w, mt)
+		}
+	})
+	writeSec(SecGlobalID, len(module.GlobalSec), func(w *wasmWriter) {
+		for _, g := range module.GlobalSec {
+			writeGlobalType(w, g.Type)
+			writeExpr(w, g.Expr)
+		}
+	})
+	writeSec(SecExportID, len(module.ExportSec), func(w *wasmWriter) {
+		for _, exp := range module.ExportSec {
+			w.writeName(exp.Name)
+			w.writeByte(exp.Desc.Tag)
+			w.writeVarU32(exp.Desc.Idx)
+		}
+	})
+	writeSec(SecStartID, getStartSecLen(module), func(w *wasmWriter) {
+		w.writeVarU32(*module.StartSec)
+	})
+	writeSec(SecElemID, len(module.ElemSec), func(w *wasmWriter) {
+		for _, elem := range module.ElemSec {
+			w.writeVarU32(uint32(elem.Flag))
+			if elem.IsDeclarative() {
+				w.writeByte(0) // elemkind funcref
+			} else {
+				writeExpr(w, elem.Offset)
+			}
+			writeIndices(w, elem.Init)
+		}
+	})
+	writeSec(SecCodeID, len(module.CodeSec), func(w *wasmWriter) {
+		for _, code := range module.CodeSec {
+			writeCode(w, code)
+		}
+	})
+	writeSec(SecDataID, len(module.DataSec), func(w *wasmWriter) {
+		for _, data := range module.DataSec {
+			w.writeVarU32(data.Mem)
+			writeExpr(w, data.Offset)
+			w.writeBytes(data.Init)
+		}
+	})
+	for _, sec := range module.CustomSecs {
+		if sec.After == CustomSecLast || sec.After > SecDataID && sec.After != CustomSecFirst {
+			writer.writeCustomSec(sec)
+		}
+	}
+	return writer.data
+}
+
+// -1 if the module has a start function (not a vector), 0 if not
+func getStartSecLen(module Module) int {
+	if module.StartSec != nil {
+		return -1
+	}
+	return 0
+}
+
+// n is the length of the vector in the section (-1 if it is not a vector),
+// empty sections are omitted
+func (writer *wasmWriter) writeSec(secID byte, n int, f func(w *wasmWriter)) {
+	if n == 0 {
+		return
+	}
+	secWriter := &wasmWriter{}
+	if n > 0 {
+		secWriter.writeVarU32(uint32(n))
+	}
+	f(secWriter)
+	writer.writeByte(secID)
+	writer.writeBytes(secWriter.data)
+}
+
+func (writer *wasmWriter) writeCustomSec(sec CustomSec) {
+	secWriter := &wasmWriter{}
+	secWriter.writeName(sec.Name)
+	secWriter.data = append(secWriter.data, sec.Data...)
+	writer.writeByte(SecCustomID)
+	writer.writeBytes(secWriter.data)
+}
+
+// writes the custom sections placed after the section
+func (writer *wasmWriter) writeCustomSecs(secs []CustomSec, after byte) {
+	for _, sec := range secs {
+		if sec.After == after {
+			writer.writeCustomSec(sec)
+		}
+	}
+}
+
+func writeIndices(writer *wasmWriter, indices []uint32) {
+	writer.writeVarU32(uint32(len(indices)))
+	for _, idx := range indices {
+		writer.writeVarU32(idx)
+	}
+}
+
+/* types */
+
+func writeValType(writer *wasmWriter, vt ValType) {
+	writer.writeByte(vt.code())
+	if vt.code() == RefNullable || vt.code() == RefNonNull {
+		writeHeapType(writer, vt)
+	}
+}
+
+func writeHeapType(writer *wasmWriter, vt ValType) {
+	if idx, ok := vt.TypeIdx(); ok {
+		writer.writeVarS64(int64(idx))
+	} else if vt == ValTypeExternRef {
+		writer.writeByte(HeapExtern)
+	} else {
+		writer.writeByte(HeapFunc)
+	}
+}
+
+func writeValTypes(writer *wasmWriter, vts []ValType) {
+	writer.writeVarU32(uint32(len(vts)))
+	for _, vt := range vts {
+		writeValType(writer, vt)
+	}
+}
+
+func writeFuncType(writer *wasmWriter, ft FuncType) {
+	writer.writeByte(0x60)
+	writeValTypes(writer, ft.ParamTypes)
+	writeValTypes(writer, ft.ResultTypes)
+}
+
+func writeLimits(writer *wasmWriter, limits Limits) {
+	writer.writeByte(limits.Tag)
+	writer.writeVarU64(limits.Min)
+	if limits.HasMax() {
+		writer.writeVarU64(limits.Max)
+	}
+}
+
+func writeTableType(writer *wasmWriter, tt TableType) {
+	writer.writeByte(tt.ElemType)
+	writeLimits(writer, tt.Limits)
+}
+
+func writeGlobalType(writer *wasmWriter, gt GlobalType) {
+	writeValType(writer, gt.ValType)
+	writer.writeByte(gt.Mut)
+}
+
+func writeImport(writer *wasmWriter, imp Import) {
+	writer.writeName(imp.Module)
+	writer.writeName(imp.Name)
+	writer.writeByte(imp.Desc.Tag)
+	switch imp.Desc.Tag {
+	case ImportTagFunc:
+		writer.writeVarU32(imp.Desc.FuncType)
+	case ImportTagTable:
+		writeTableType(writer, imp.Desc.Table)
+	case ImportTagMem:
+		writeLimits(writer, imp.Desc.Mem)
+	case ImportTagGlobal:
+		writeGlobalType(writer, imp.Desc.Global)
+	}
+}
+
+/* code */
+
+func writeCode(writer *wasmWriter, code Code) {
+	codeWriter := &wasmWriter{}
+	codeWriter.writeVarU32(uint32(len(code.Locals)))
+	for _, locals := range code.Locals {
+		codeWriter.writeVarU32(locals.N)
+		writeValType(codeWriter, locals.Type)
+	}
+	writeExpr(codeWriter, code.Expr)
+	writer.writeBytes(codeWriter.data)
+}
+
+func writeExpr(writer *wasmWriter, expr Expr) {
+	writeInstructions(writer, expr)
+	writer.writeByte(_End)
+}
+
+func writeInstructions(writer *wasmWriter, instrs []Instruction) {
+	for _, instr := range instrs {
+		writer.writeByte(instr.Opcode)
+		writeArgs(writer, instr)
+	}
+}
+
+func writeArgs(writer *wasmWriter, instr Instruction) {
+	switch instr.Opcode {
+	case Block, Loop:
+		args := instr.Args.(BlockArgs)
+		writeBlockType(writer, args.RT)
+		writeExpr(writer, args.Instrs)
+	case If:
+		args := instr.Args.(IfArgs)
+		writeBlockType(writer, args.RT)
+		writeInstructions(writer, args.Instrs1)
+		if len(args.Instrs2) > 0 {
+			writer.writeByte(_Else)
+			writeInstructions(writer, args.Instrs2)
+		}
+		writer.writeByte(_End)
+	case Br, BrIf, BrOnNull, BrOnNonNull, Call, CallRef, ReturnCallRef,
+		RefFunc, LocalGet, LocalSet, LocalTee, GlobalGet, GlobalSet:
+		writer.writeVarU32(instr.Args.(uint32))
+	case BrTable:
+		args := instr.Args.(BrTableArgs)
+		writeIndices(writer, args.Labels)
+		writer.writeVarU32(args.Default)
+	case CallIndirect:
+		writer.writeVarU32(instr.Args.(uint32))
+		writer.writeByte(0)
+	case RefNull:
+		writeHeapType(writer, instr.Args.(ValType))
+	case MemorySize, MemoryGrow:
+		writer.writeByte(0)
+	case I32Const:
+		writer.writeVarS32(instr.Args.(int32))
+	case I64Const:
+		writer.writeVarS64(instr.Args.(int64))
+	case F32Const:
+		writer.writeF32(instr.Args.(float32))
+	case F64Const:
+		writer.writeF64(instr.Args.(float64))
+	default:
+		if instr.Opcode >= I32Load && instr.Opcode <= I64Store32 {
+			memArg := instr.Args.(MemArg)
+			writer.writeVarU32(memArg.Align)
+			writer.writeVarU64(memArg.Offset)
+		} else if instr.Args != nil {
+			panic(fmt.Errorf("unexpected args of %s: %v", instr, instr.Args))
+		}
+	}
+}
+
+func writeBlockType(writer *wasmWriter, rt BlockType) {
+	if len(rt) == 0 {
+		writer.writeByte(NoVal)
+	} else {
+		writeValType(writer, rt[0])
+	}
+}
@@ -0,0 +1,114 @@
+package binary
+
+import (
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodeRoundTrip(t *testing.T) {
+	data, err := ioutil.ReadFile("./testdata/hw_rust.wasm")
+	require.NoError(t, err)
+	m, err := Decode(data)
+	require.NoError(t, err)
+	require.Equal(t, data, Encode(m))
+}
+
+func TestEncodeBuilderModule(t *testing.T) {
+	i2i := FuncType{
+		ParamTypes:  []ValType{ValTypeI32},
+		ResultTypes: []ValType{ValTypeI32},
+	}
+	ref := RefType(true, 0)
+
+	b := NewBuilder()
+	_, err := b.ImportMem("env", "mem", MemType{Tag: 1, Min: 1, Max: 2})
+	require.NoError(t, err)
+	b.AddTable(TableType{ElemType: FuncRef, Limits: Limits{Min: 1}})
+	g := b.AddGlobal(GlobalType{ValType: ValTypeF64, Mut: 1},
+		Expr{{Opcode: F64Const, Args: -1.5}})
+	f := b.AddFunc(i2i, []ValType{ref, ValTypeI64}, Expr{
+		{Opcode: LocalGet, Args: uint32(0)},
+		{Opcode: If, Args: IfArgs{
+			RT:      []ValType{ValTypeI32},
+			Instrs1: Expr{{Opcode: I32Load, Args: MemArg{Align: 2, Offset: 300}}},
+			Instrs2: Expr{{Opcode: I32Const, Args: int32(-123456)}},
+		}},
+		{Opcode: Block, Args: BlockArgs{Instrs: Expr{
+			{Opcode: RefNull, Args: ref},
+			{Opcode: BrOnNull, Args: uint32(0)},
+			{Opcode: Drop},
+		}}},
+		{Opcode: BrTable, Args: BrTableArgs{Labels: []LabelIdx{0, 0}}},
+	})
+	b.ExportFunc("f", f)
+	b.ExportGlobal("g", g)
+	b.AddElem(0, Expr{{Opcode: I32Const, Args: int32(0)}}, []FuncIdx{f})
+	b.DeclareElem([]FuncIdx{f})
+	b.AddData(0, Expr{{Opcode: I32Const, Args: int32(8)}}, []byte("hi"))
+	b.AddCustom("foo", []byte{1, 2, 3})
+
+	data := Encode(b.Module())
+	m, err := DecodeWithOptions(data, DecodeOptions{Features: AllFeatures})
+	require.NoError(t, err)
+	require.Equal(t, data, Encode(m))
+
+	require.Equal(t, []Locals{{N: 1, Type: ref}, {N: 1, Type: ValTypeI64}},
+		m.CodeSec[0].Locals)
+	require.Equal(t, -1.5, m.GlobalSec[0].Expr[0].Args)
+	require.Equal(t, MemArg{Align: 2, Offset: 300},
+		m.CodeSec[0].Expr[1].Args.(IfArgs).Instrs1[0].Args)
+	require.Equal(t, ref, m.CodeSec[0].Expr[2].Args.(BlockArgs).Instrs[0].Args)
+	require.Equal(t, Elem{Flag: ElemFlagDeclarative, Init: []FuncIdx{f}}, m.ElemSec[1])
+	require.Equal(t, []CustomSec{{Name: "foo", Data: []byte{1, 2, 3}, After: SecDataID}},
+		m.CustomSecs)
+}
+
+func TestEncodeCustomSecPlacement(t *testing.T) {
+	b := NewBuilder()
+	b.AddType(FuncType{})
+	b.AddMem(MemType{Min: 1})
+	m := b.Module()
+	m.CustomSecs = []CustomSec{
+		{Name: "last", Data: []byte{1}},
+		{Name: "type", Data: []byte{2}, After: SecTypeID},
+		{Name: "first", Data: []byte{3}, After: CustomSecFirst},
+		{Name: "func", Data: []byte{4}, After: SecFuncID},
+	}
+
+	data := Encode(m)
+	m2, err := Decode(data)
+	require.NoError(t, err)
+	require.Equal(t, data, Encode(m2))
+
+	var ids []byte
+	for _, sec := range m2.Sections {
+		ids = append(ids, sec.ID)
+	}
+	require.Equal(t, []byte{SecCustomID, SecTypeID, SecCustomID, SecCustomID,
+		SecMemID, SecCustomID}, ids)
+	require.Equal(t, []CustomSec{
+		{Name: "first", Data: []byte{3}, After: CustomSecFirst},
+		{Name: "type", Data: []byte{2}, After: SecTypeID},
+		{Name: "func", Data: []byte{4}, After: SecTypeID},
+		{Name: "last", Data: []byte{1}, After: SecMemID},
+	}, m2.CustomSecs)
+}
+
+func TestEncodeNameSec(t *testing.T) {
+	sec := NameSec{
+		ModuleName: "m",
+		FuncNames:  NameMap{2: "c", 0: "a", 1: "b"},
+		LocalNames: map[FuncIdx]NameMap{1: {0: "x", 1: "y"}, 0: {}},
+	}
+	data := sec.Encode()
+	decoded, err := DecodeNameSec(data)
+	require.NoError(t, err)
+	require.Equal(t, sec, decoded)
+	require.Equal(t, data, decoded.Encode())
+
+	decoded, err = DecodeNameSec(NameSec{}.Encode())
+	require.NoError(t, err)
+	require.Equal(t, NameSec{FuncNames: NameMap{}, LocalNames: map[FuncIdx]NameMap{}}, decoded)
+}
@@ -0,0 +1,123 @@
+package binary
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Features is a set of post-MVP proposals which a module may use.
+// The proposals standardized by Wasm 2.0 are enabled by default, even
+// those whose instructions are recognized but not implemented yet.
+type Features uint32
+
+const (
+	FeatureSignExtension Features = 1 << iota
+	FeatureSaturatingFloatToInt
+	FeatureMultiValue
+	FeatureBulkMemory
+	FeatureReferenceTypes
+	FeatureSIMD
+	FeatureTailCall
+	FeatureExtendedConst
+	FeatureFunctionReferences
+	FeatureMemory64
+)
+
+const (
+	AllFeatures     = FeatureMemory64<<1 - 1
+	DefaultFeatures = FeatureSignExtension | FeatureSaturatingFloatToInt |
+		FeatureMultiValue | FeatureBulkMemory | FeatureReferenceTypes | FeatureSIMD
+)
+
+var featureNames = []string{
+	"sign-extension",
+	"saturating-float-to-int",
+	"multi-value",
+	"bulk-memory",
+	"reference-types",
+	"simd",
+	"tail-call",
+	"extended-const",
+	"function-references",
+	"memory64",
+}
+
+func FeatureNames() []string {
+	return append([]string(nil), featureNames...)
+}
+
+func ParseFeature(name string) (Features, error) {
+	for i, fName := range featureNames {
+		if fName == name {
+			return 1 << i, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown feature: %s", name)
+}
+
+func (f Features) Has(x Features) bool {
+	return f&x == x
+}
+
+// returns an error naming the missing features
+func (f Features) Check(what string, required Features) error {
+	if missing := required &^ f; missing != 0 {
+		return fmt.Errorf("%s requires feature %s", what, missing)
+	}
+	return nil
+}
+
+func (f Features) String() string {
+	var names []string
+	for i, name := range featureNames {
+		if f&(1<<i) != 0 {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return "mvp"
+	}
+	return strings.Join(names, ",")
+}
+
+func OpcodeFeatures(opcode byte) Features {
+	switch {
+	case opcode >= I32Extend8S && opcode <= I64Extend32S:
+		return FeatureSignExtension
+	}
+	switch opcode {
+	case RefNull, RefIsNull, RefFunc:
+		return FeatureReferenceTypes
+	case CallRef, RefAsNonNull, BrOnNull, BrOnNonNull:
+		return FeatureFunctionReferences
+	case ReturnCallRef:
+		return FeatureFunctionReferences | FeatureTailCall
+	default:
+		return 0
+	}
+}
+
+// features of the instructions prefixed by 0xFC
+func PrefixFCFeatures(subop uint32) Features {
+	switch {
+	case subop <= 7: // *.trunc_sat_*
+		return FeatureSaturatingFloatToInt
+	case subop <= 14: // memory.init ... table.copy
+		return FeatureBulkMemory
+	default: // table.grow, table.size, table.fill
+		return FeatureReferenceTypes
+	}
+}
+
+func ValTypeFeatures(vt ValType) Features {
+	switch {
+	case vt == ValTypeV128:
+		return FeatureSIMD
+	case vt == ValTypeFuncRef || vt == ValTypeExternRef:
+		return FeatureReferenceTypes
+	case vt.IsRef():
+		return FeatureFunctionReferences
+	default:
+		return 0
+	}
+}
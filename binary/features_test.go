@@ -0,0 +1,70 @@
+package binary
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFeature(t *testing.T) {
+	for _, name := range FeatureNames() {
+		f, err := ParseFeature(name)
+		require.NoError(t, err)
+		require.Equal(t, name, f.String())
+	}
+	_, err := ParseFeature("foo")
+	require.Error(t, err)
+
+	require.Equal(t, "mvp", Features(0).String())
+	require.Equal(t, "tail-call,function-references",
+		(FeatureTailCall | FeatureFunctionReferences).String())
+	require.Equal(t, "sign-extension,saturating-float-to-int,multi-value,"+
+		"bulk-memory,reference-types,simd", DefaultFeatures.String())
+	require.False(t, DefaultFeatures.Has(FeatureTailCall))
+}
+
+func TestDecodeFeatures(t *testing.T) {
+	reader := &WasmReader{features: FeatureReferenceTypes, data: []byte{
+		RefAsNonNull,
+	}}
+	_, err := readInstruction(reader)
+	require.EqualError(t, err, "ref.as_non_null requires feature function-references")
+
+	reader = &WasmReader{features: FeatureFunctionReferences, data: []byte{
+		ReturnCallRef, 0x00,
+	}}
+	_, err = readInstruction(reader)
+	require.EqualError(t, err, "return_call_ref requires feature tail-call")
+
+	reader = &WasmReader{data: []byte{0x63, 0x00}}
+	_, err = readValType(reader)
+	require.EqualError(t, err, "(ref null 0) requires feature function-references")
+
+	reader = &WasmReader{data: []byte{0x04, 0x01}}
+	_, err = readMemType(reader)
+	require.EqualError(t, err, "64-bit memory requires feature memory64")
+
+	reader = &WasmReader{data: []byte{I64Extend32S}}
+	_, err = readInstruction(reader)
+	require.EqualError(t, err, "i64.extend32_s requires feature sign-extension")
+
+	reader = &WasmReader{data: []byte{PrefixFC, 0x0A}}
+	_, err = readInstruction(reader)
+	require.EqualError(t, err, "memory.copy requires feature bulk-memory")
+
+	reader = &WasmReader{features: DefaultFeatures, data: []byte{PrefixFC, 0x02}}
+	_, err = readInstruction(reader)
+	require.EqualError(t, err, "unsupported opcode: i32.trunc_sat_f64_s")
+
+	reader = &WasmReader{data: []byte{PrefixSIMD, 0x0C}}
+	_, err = readInstruction(reader)
+	require.EqualError(t, err, "0xfd 12 requires feature simd")
+
+	reader = &WasmReader{data: []byte{0x7B}}
+	_, err = readValType(reader)
+	require.EqualError(t, err, "v128 requires feature simd")
+
+	reader = &WasmReader{features: FeatureSIMD, data: []byte{0x7B}}
+	_, err = readValType(reader)
+	require.EqualError(t, err, "unsupported valtype: v128")
+}
@@ -0,0 +1,34 @@
+//go:build go1.18
+// +build go1.18
+
+package binary
+
+import (
+	"io/ioutil"
+	"runtime"
+	"testing"
+)
+
+// go test -fuzz=FuzzDecode ./binary
+func FuzzDecode(f *testing.F) {
+	data, err := ioutil.ReadFile("./testdata/hw_rust.wasm")
+	if err != nil {
+		f.Fatal(err)
+	}
+	f.Add(data)
+	f.Add(wasmModule(section(SecTypeID, 0xff, 0xff, 0xff, 0xff, 0x0f)))
+	f.Add(wasmModule(section(SecCodeID, 1, 8, 1, 0xff, 0xff, 0xff, 0xff, 0x0f, 0x7f, _End)))
+
+	limits := DecodeLimits{MaxAllocation: 1 << 20}
+	f.Fuzz(func(t *testing.T, data []byte) {
+		var before, after runtime.MemStats
+		runtime.ReadMemStats(&before)
+		_, _ = DecodeWithOptions(data,
+			DecodeOptions{Features: AllFeatures, Limits: limits})
+		runtime.ReadMemStats(&after)
+		// decoded vectors are bounded by the limit, the rest by the input
+		if n := after.TotalAlloc - before.TotalAlloc; n > 4<<20+64*uint64(len(data)) {
+			t.Fatalf("allocated %d bytes for %d bytes of input", n, len(data))
+		}
+	})
+}
@@ -1,12 +1,16 @@
 package binary
 
-import "fmt"
+import (
+	"fmt"
+	"unsafe"
+)
 
 type Expr = []Instruction
 
 type Instruction struct {
 	Opcode byte
 	Args   interface{}
+	Offset uint32 // offset in module
 }
 
 // block & loop
@@ -28,7 +32,7 @@ type BrTableArgs struct {
 
 type MemArg struct {
 	Align  uint32
-	Offset uint32
+	Offset uint64
 }
 
 func readExpr(reader *WasmReader) (Expr, error) {
@@ -53,29 +57,62 @@ func readInstructions(reader *WasmReader) (instrs []Instruction, end byte, err e
 		if instr, err = readInstruction(reader); err != nil {
 			return
 		}
+		if err = reader.limiter.alloc(uint64(unsafe.Sizeof(instr))); err != nil {
+			return
+		}
 		instrs = append(instrs, instr)
 	}
 }
 
 func readInstruction(reader *WasmReader) (instr Instruction, err error) {
+	instr.Offset = uint32(reader.pos())
 	if instr.Opcode, err = reader.readByte(); err != nil {
 		return
 	}
+	switch instr.Opcode {
+	case PrefixFC, PrefixSIMD:
+		err = readPrefixedOpcode(reader, instr.Opcode)
+		return
+	}
 	if opnames[instr.Opcode] == "" {
 		err = fmt.Errorf("undefined opcode: 0x%02x", instr.Opcode)
 		return
 	}
+	if err = reader.features.Check(opnames[instr.Opcode],
+		OpcodeFeatures(instr.Opcode)); err != nil {
+		return
+	}
 	instr.Args, err = readArgs(reader, instr.Opcode)
 	return
 }
 
+// prefixed instructions are recognized so that the missing feature can
+// be reported, but none of them is implemented yet
+func readPrefixedOpcode(reader *WasmReader, prefix byte) error {
+	subop, err := reader.readVarU32()
+	if err != nil {
+		return err
+	}
+	name, features := fmt.Sprintf("0x%02x %d", prefix, subop), FeatureSIMD
+	if prefix == PrefixFC {
+		if subop >= uint32(len(prefixFCNames)) {
+			return fmt.Errorf("undefined opcode: %s", name)
+		}
+		name, features = prefixFCNames[subop], PrefixFCFeatures(subop)
+	}
+	if err := reader.features.Check(name, features); err != nil {
+		return err
+	}
+	return fmt.Errorf("unsupported opcode: %s", name)
+}
+
 func readArgs(reader *WasmReader, opcode byte) (interface{}, error) {
 	switch opcode {
 	case Block, Loop:
 		return readBlockArgs(reader)
 	case If:
 		return readIfArgs(reader)
-	case Br, BrIf:
+	case Br, BrIf, BrOnNull, BrOnNonNull:
 		return reader.readVarU32() // label_idx
 	case BrTable:
 		return readBrTableArgs(reader)
@@ -83,6 +120,12 @@ func readArgs(reader *WasmReader, opcode byte) (interface{}, error) {
 		return reader.readVarU32() // func_idx
 	case CallIndirect:
 		return readCallIndirectArgs(reader)
+	case CallRef, ReturnCallRef:
+		return reader.readVarU32() // type_idx
+	case RefNull:
+		return readRefNullArgs(reader)
+	case RefFunc:
+		return reader.readVarU32() // func_idx
 	case LocalGet, LocalSet, LocalTee:
 		return reader.readVarU32() // local_idx
 	case GlobalGet, GlobalSet:
@@ -109,6 +152,10 @@ func readBlockArgs(reader *WasmReader) (args BlockArgs, err error) {
 	if args.RT, err = readBlockType(reader); err != nil {
 		return
 	}
+	if err = reader.enterBlock(); err != nil {
+		return
+	}
+	defer reader.exitBlock()
 	var end byte
 	if args.Instrs, end, err = readInstructions(reader); err != nil {
 		return
@@ -124,6 +171,10 @@ func readIfArgs(reader *WasmReader) (args IfArgs, err error) {
 	if args.RT, err = readBlockType(reader); err != nil {
 		return
 	}
+	if err = reader.enterBlock(); err != nil {
+		return
+	}
+	defer reader.exitBlock()
 	var end byte
 	if args.Instrs1, end, err = readInstructions(reader); err != nil {
 		return
@@ -156,14 +207,44 @@ func readCallIndirectArgs(reader *WasmReader) (typeIdx uint32, err error) {
 	return
 }
 
+// returns the type of the null reference
+func readRefNullArgs(reader *WasmReader) (ValType, error) {
+	ht, err := readHeapType(reader)
+	if err != nil {
+		return 0, err
+	}
+	switch ht {
+	case heapFunc:
+		return ValTypeFuncRef, nil
+	case heapExtern:
+		return ValTypeExternRef, nil
+	}
+	vt := RefType(true, uint32(ht))
+	return vt, reader.features.Check(vt.String(), FeatureFunctionReferences)
+}
+
 func readMemArg(reader *WasmReader) (memArg MemArg, err error) {
 	if memArg.Align, err = reader.readVarU32(); err != nil {
 		return
 	}
-	memArg.Offset, err = reader.readVarU32()
+	memArg.Offset, err = reader.readVarU64()
 	return
 }
 
+// log2 of the access width of a load or store
+func NaturalAlign(opcode byte) uint32 {
+	switch opcode {
+	case I32Load8S, I32Load8U, I64Load8S, I64Load8U, I32Store8, I64Store8:
+		return 0
+	case I32Load16S, I32Load16U, I64Load16S, I64Load16U, I32Store16, I64Store16:
+		return 1
+	case I32Load, F32Load, I64Load32S, I64Load32U, I32Store, F32Store, I64Store32:
+		return 2
+	default: // I64Load, F64Load, I64Store, F64Store
+		return 3
+	}
+}
+
 func readZero(reader *WasmReader) error {
 	b, err := reader.readByte()
 	if err != nil {
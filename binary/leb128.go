@@ -32,3 +32,27 @@ func readVarInt(data []byte, size int) (int64, int) {
 	}
 	return 0, 0
 }
+
+// https://en.wikipedia.org/wiki/LEB128#Encode_unsigned_integer
+func appendVarUint(data []byte, val uint64) []byte {
+	for {
+		b := byte(val & 0x7f)
+		val >>= 7
+		if val == 0 {
+			return append(data, b)
+		}
+		data = append(data, b|0x80)
+	}
+}
+
+// https://en.wikipedia.org/wiki/LEB128#Encode_signed_integer
+func appendVarInt(data []byte, val int64) []byte {
+	for {
+		b := byte(val & 0x7f)
+		val >>= 7
+		if val == 0 && b&0x40 == 0 || val == -1 && b&0x40 != 0 {
+			return append(data, b)
+		}
+		data = append(data, b|0x80)
+	}
+}
@@ -1,6 +1,7 @@
 package binary
 
 import (
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -27,6 +28,19 @@ func TestVarInt(t *testing.T) {
 	testVarInt32(t, data, int32(-123456), 3)
 }
 
+func TestAppendVarInt(t *testing.T) {
+	require.Equal(t, []byte{0xE5, 0x8E, 0x26}, appendVarUint(nil, 624485))
+	require.Equal(t, []byte{0xC0, 0xBB, 0x78}, appendVarInt(nil, -123456))
+	require.Equal(t, []byte{0x3F}, appendVarInt(nil, 63))
+	require.Equal(t, []byte{0xC0, 0x00}, appendVarInt(nil, 64))
+	for _, n := range []int64{0, -1, 1 << 40, math.MinInt64, math.MaxInt64} {
+		_n, _ := readVarInt(appendVarInt(nil, n), 64)
+		require.Equal(t, n, _n)
+	}
+	_n, _ := readVarUint(appendVarUint(nil, math.MaxUint64), 64)
+	require.Equal(t, uint64(math.MaxUint64), _n)
+}
+
 func testVarUint32(t *testing.T, data []byte, n uint32, w int) {
 	_n, _w := readVarUint(data, 32)
 	require.Equal(t, n, uint32(_n))
@@ -0,0 +1,108 @@
+package binary
+
+import "fmt"
+
+// DecodeLimits bounds the resources used to decode untrusted modules.
+// Zero fields mean the values of DefaultDecodeLimits.
+type DecodeLimits struct {
+	MaxFuncs        uint32 // imported and defined functions
+	MaxLocals       uint32 // locals (not including params) per function
+	MaxSectionSize  uint32 // bytes
+	MaxNestingDepth int    // nested blocks
+	MaxAllocation   uint64 // total bytes allocated for decoded vectors
+}
+
+var DefaultDecodeLimits = DecodeLimits{
+	MaxFuncs:        1000000,
+	MaxLocals:       50000,
+	MaxSectionSize:  1 << 30,
+	MaxNestingDepth: 1024,
+	MaxAllocation:   1 << 30,
+}
+
+// shared by all readers of a module, nil means no limits
+type limiter struct {
+	DecodeLimits
+	funcs     uint64
+	allocated uint64
+}
+
+func newLimiter(limits DecodeLimits) *limiter {
+	l := &limiter{DecodeLimits: DefaultDecodeLimits}
+	if limits.MaxFuncs > 0 {
+		l.MaxFuncs = limits.MaxFuncs
+	}
+	if limits.MaxLocals > 0 {
+		l.MaxLocals = limits.MaxLocals
+	}
+	if limits.MaxSectionSize > 0 {
+		l.MaxSectionSize = limits.MaxSectionSize
+	}
+	if limits.MaxNestingDepth > 0 {
+		l.MaxNestingDepth = limits.MaxNestingDepth
+	}
+	if limits.MaxAllocation > 0 {
+		l.MaxAllocation = limits.MaxAllocation
+	}
+	return l
+}
+
+func (l *limiter) alloc(n uint64) error {
+	if l == nil {
+		return nil
+	}
+	if l.allocated += n; l.allocated > l.MaxAllocation {
+		return fmt.Errorf("allocation exceeds limit: %d bytes", l.MaxAllocation)
+	}
+	return nil
+}
+
+func (l *limiter) addFuncs(n uint64) error {
+	if l == nil {
+		return nil
+	}
+	if l.funcs += n; l.funcs > uint64(l.MaxFuncs) {
+		return fmt.Errorf("too many functions: %d (limit %d)", l.funcs, l.MaxFuncs)
+	}
+	return nil
+}
+
+func (l *limiter) checkFuncs(n uint32) error {
+	if l == nil {
+		return nil
+	}
+	if n > l.MaxFuncs {
+		return fmt.Errorf("too many functions: %d (limit %d)", n, l.MaxFuncs)
+	}
+	return nil
+}
+
+func (l *limiter) checkLocals(n uint64) error {
+	if l == nil {
+		return nil
+	}
+	if n > uint64(l.MaxLocals) {
+		return fmt.Errorf("too many locals: %d (limit %d)", n, l.MaxLocals)
+	}
+	return nil
+}
+
+func (l *limiter) checkSectionSize(n uint32) error {
+	if l == nil {
+		return nil
+	}
+	if n > l.MaxSectionSize {
+		return fmt.Errorf("section too large: %d bytes (limit %d)", n, l.MaxSectionSize)
+	}
+	return nil
+}
+
+func (l *limiter) checkDepth(depth int) error {
+	if l == nil {
+		return nil
+	}
+	if depth > l.MaxNestingDepth {
+		return fmt.Errorf("nesting depth exceeds limit: %d", l.MaxNestingDepth)
+	}
+	return nil
+}
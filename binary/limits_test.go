@@ -0,0 +1,90 @@
+package binary
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestDecodeLimits(t *testing.T) {
+	decode := func(limits DecodeLimits, secs ...[]byte) error {
+		_, err := DecodeWithOptions(wasmModule(secs...),
+			DecodeOptions{Features: DefaultFeatures, Limits: limits})
+		return err
+	}
+	typeSec := section(SecTypeID, 1, 0x60, 0, 0)             // () -> ()
+	funcSec := section(SecFuncID, 2, 0, 0)                   // 2 funcs
+	codeSec := section(SecCodeID, 2, 2, 0, _End, 2, 0, _End) // 2 empty bodies
+	require.NoError(t, decode(DecodeLimits{}, typeSec, funcSec, codeSec))
+
+	err := decode(DecodeLimits{MaxFuncs: 1}, typeSec, funcSec, codeSec)
+	require.EqualError(t, err, "too many functions: 2 (limit 1)")
+
+	err = decode(DecodeLimits{MaxSectionSize: 4}, typeSec, funcSec, codeSec)
+	require.EqualError(t, err, "section too large: 7 bytes (limit 4)")
+
+	err = decode(DecodeLimits{MaxAllocation: 16}, typeSec, funcSec, codeSec)
+	require.EqualError(t, err, "allocation exceeds limit: 16 bytes")
+
+	err = decode(DecodeLimits{}, section(SecTypeID, 0xff, 0xff, 0xff, 0xff, 0x0f))
+	require.EqualError(t, err, "vector length out of bounds: 4294967295")
+
+	// 0xffffffff i32 locals
+	err = decode(DecodeLimits{}, typeSec, section(SecFuncID, 1, 0),
+		section(SecCodeID, 1, 8, 1, 0xff, 0xff, 0xff, 0xff, 0x0f, 0x7f, _End))
+	require.EqualError(t, err, "too many locals: 4294967295 (limit 50000)")
+
+	body := []byte{0}
+	for i := 0; i < 10; i++ {
+		body = append(body, Block, NoVal)
+	}
+	for i := 0; i < 11; i++ {
+		body = append(body, _End)
+	}
+	code := append([]byte{1, byte(len(body))}, body...)
+	err = decode(DecodeLimits{MaxNestingDepth: 10}, typeSec,
+		section(SecFuncID, 1, 0), section(SecCodeID, code...))
+	require.NoError(t, err)
+	err = decode(DecodeLimits{MaxNestingDepth: 9}, typeSec,
+		section(SecFuncID, 1, 0), section(SecCodeID, code...))
+	require.EqualError(t, err, "nesting depth exceeds limit: 9")
+}
+
+func TestDecoderLimits(t *testing.T) {
+	data := wasmModule(section(SecTypeID, 1, 0x60, 0, 0), section(SecFuncID, 1, 0),
+		section(SecCodeID, 1, 0xff, 0xff, 0x03, 0))
+	d := NewDecoder(bytes.NewReader(data))
+	d.OnFuncBody = func(idx uint32, code Code) error { return nil }
+	require.EqualError(t, d.Decode(), "function body out of bounds: 65535")
+
+	data = wasmModule(section(SecTypeID, 1, 0x60, 0, 0))
+	d = NewDecoder(bytes.NewReader(data))
+	d.Limits.MaxSectionSize = 2
+	require.EqualError(t, d.Decode(), "section too large: 4 bytes (limit 2)")
+
+	typeSec := section(SecTypeID, 1, 0x60, 0, 0)
+	funcSec := section(SecFuncID, 2, 0, 0)
+	codeSec := section(SecCodeID, 2, 2, 0, _End, 2, 0, _End)
+	d = NewDecoder(bytes.NewReader(wasmModule(typeSec, funcSec)))
+	d.Limits.MaxFuncs = 1 // counted without callbacks
+	require.EqualError(t, d.Decode(), "too many functions: 2 (limit 1)")
+
+	d = NewDecoder(bytes.NewReader(wasmModule(typeSec, funcSec, codeSec)))
+	d.OnType = func(ft FuncType) error { return nil }
+	d.Limits.MaxAllocation = 2
+	require.EqualError(t, d.Decode(), "allocation exceeds limit: 2 bytes")
+}
+
+func wasmModule(secs ...[]byte) []byte {
+	data := []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+	for _, sec := range secs {
+		data = append(data, sec...)
+	}
+	return data
+}
+
+// contents must be shorter than 128 bytes
+func section(id byte, contents ...byte) []byte {
+	return append([]byte{id, byte(len(contents))}, contents...)
+}
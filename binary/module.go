@@ -1,6 +1,7 @@
 package binary
 
 import (
+	"errors"
 	"fmt"
 	"io/ioutil"
 )
@@ -28,6 +29,7 @@ const (
 type Module struct {
 	Magic      uint32
 	Version    uint32
+	Sections   []Section
 	CustomSecs []CustomSec
 	TypeSec    []FuncType
 	ImportSec  []Import
@@ -42,16 +44,41 @@ type Module struct {
 	DataSec    []Data
 }
 
+type Section struct {
+	ID     byte
+	Offset uint32 // offset of contents
+	Size   uint32
+}
+
+type DecodeOptions struct {
+	Features Features
+	Limits   DecodeLimits
+}
+
 func DecodeFile(filename string) (Module, error) {
+	return DecodeFileWithOptions(filename,
+		DecodeOptions{Features: DefaultFeatures})
+}
+
+func DecodeFileWithOptions(filename string, opts DecodeOptions) (Module, error) {
 	data, err := ioutil.ReadFile(filename)
 	if err != nil {
 		return Module{}, err
 	}
-	return Decode(data)
+	return DecodeWithOptions(data, opts)
 }
 
 func Decode(data []byte) (Module, error) {
-	reader := WasmReader{data: data}
+	return DecodeWithOptions(data, DecodeOptions{Features: DefaultFeatures})
+}
+
+func DecodeWithOptions(data []byte, opts DecodeOptions) (Module, error) {
+	reader := WasmReader{
+		data:     data,
+		features: opts.Features,
+		end:      len(data),
+		limiter:  newLimiter(opts.Limits),
+	}
 	return readModule(&reader)
 }
 
@@ -75,6 +102,9 @@ func readModule(reader *WasmReader) (module Module, err error) {
 	return
 }
 
+var errInconsistentCodeCount = errors.New(
+	"function and code section have inconsistent lengths")
+
 func readSections(reader *WasmReader, module *Module) (err error) {
 	lastSecID := byte(0)
 	for reader.remaining() > 0 {
@@ -83,7 +113,7 @@ func readSections(reader *WasmReader, module *Module) (err error) {
 			return
 		}
 		if secID > SecCustomID {
-			if secID < lastSecID {
+			if secID <= lastSecID {
 				err = fmt.Errorf("invalid sec ID: %d", secID)
 				return
 			}
@@ -94,23 +124,34 @@ func readSections(reader *WasmReader, module *Module) (err error) {
 		if secCont, err = reader.readBytes(); err != nil {
 			return
 		}
-		if err = decodeSec(secID, secCont, module); err != nil {
+		if err = reader.limiter.checkSectionSize(uint32(len(secCont))); err != nil {
+			return
+		}
+		if err = decodeSec(secID, reader.subReader(secCont), module); err != nil {
 			return
 		}
 	}
+	if len(module.CodeSec) != len(module.FuncSec) {
+		err = errInconsistentCodeCount
+	}
 	return
 }
 
-func decodeSec(secID byte, cont []byte, module *Module) (err error) {
-	secReader := WasmReader{data: cont}
+func decodeSec(secID byte, secReader *WasmReader, module *Module) (err error) {
+	module.Sections = append(module.Sections, Section{
+		ID:     secID,
+		Offset: uint32(secReader.pos()),
+		Size:   uint32(secReader.remaining()),
+	})
 	if secID == SecCustomID {
 		var sec CustomSec
-		if sec, err = readCustomSec(&secReader); err != nil {
+		if sec, err = readCustomSec(secReader); err != nil {
 			return
 		}
+		sec.After = module.getLastSecID()
 		module.CustomSecs = append(module.CustomSecs, sec)
 	} else {
-		if err = readNonCustomSec(secID, &secReader, module); err != nil {
+		if err = readNonCustomSec(secID, secReader, module); err != nil {
 			return
 		}
 	}
@@ -120,6 +161,16 @@ func decodeSec(secID byte, cont []byte, module *Module) (err error) {
 	return
 }
 
+// the ID of the last non-custom section read, CustomSecFirst if none
+func (module *Module) getLastSecID() byte {
+	for i := len(module.Sections) - 1; i >= 0; i-- {
+		if id := module.Sections[i].ID; id != SecCustomID {
+			return id
+		}
+	}
+	return CustomSecFirst
+}
+
 func readNonCustomSec(secID byte, reader *WasmReader, module *Module) (err error) {
 	switch secID {
 	case SecTypeID:
@@ -127,7 +178,7 @@ func readNonCustomSec(secID byte, reader *WasmReader, module *Module) (err error
 	case SecImportID:
 		module.ImportSec, err = readImportSec(reader)
 	case SecFuncID:
-		module.FuncSec, err = readIndices(reader)
+		module.FuncSec, err = readFuncSec(reader)
 	case SecTableID:
 		module.TableSec, err = readTableSec(reader)
 	case SecMemID:
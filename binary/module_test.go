@@ -1,6 +1,8 @@
 package binary
 
 import (
+	"bytes"
+	"fmt"
 	"io/ioutil"
 	"testing"
 
@@ -27,3 +29,39 @@ func TestDecode(t *testing.T) {
 	require.Equal(t, 171, len(module.CodeSec))
 	require.Equal(t, 4, len(module.DataSec))
 }
+
+func TestOffsets(t *testing.T) {
+	data, err := ioutil.ReadFile("./testdata/hw_rust.wasm")
+	require.NoError(t, err)
+	module, err := Decode(data)
+	require.NoError(t, err)
+
+	require.Equal(t, 11, len(module.Sections))
+	for _, sec := range module.Sections {
+		require.True(t, int(sec.Offset+sec.Size) <= len(data))
+	}
+	last := module.Sections[len(module.Sections)-1]
+	require.Equal(t, len(data), int(last.Offset+last.Size))
+
+	var offsets []uint32
+	d := NewDecoder(bytes.NewReader(data))
+	d.OnFuncBody = func(idx uint32, code Code) error {
+		offsets = append(offsets, code.Offset)
+		return nil
+	}
+	require.NoError(t, d.Decode())
+
+	for i, code := range module.CodeSec {
+		require.Equal(t, offsets[i], code.Offset)
+		for _, instr := range code.Expr {
+			require.Equal(t, instr.Opcode, data[instr.Offset])
+			require.True(t, instr.Offset > code.Offset)
+		}
+	}
+
+	code := module.CodeSec[0]
+	instr := code.Expr[0]
+	require.Equal(t, fmt.Sprintf("func[3]+0x%x", instr.Offset-code.Offset),
+		code.Location(3, instr))
+	require.Equal(t, "func[3]", Code{}.Location(3, instr))
+}
@@ -15,6 +15,8 @@ const (
 	Return            = 0x0F // return
 	Call              = 0x10 // call x
 	CallIndirect      = 0x11 // call_indirect x
+	CallRef           = 0x14 // call_ref x
+	ReturnCallRef     = 0x15 // return_call_ref x
 	Drop              = 0x1A // drop
 	Select            = 0x1B // select
 	LocalGet          = 0x20 // local.get x
@@ -174,4 +176,17 @@ const (
 	I64ReinterpretF64 = 0xBD // i64.reinterpret_f64
 	F32ReinterpretI32 = 0xBE // f32.reinterpret_i32
 	F64ReinterpretI64 = 0xBF // f64.reinterpret_i64
+	I32Extend8S       = 0xC0 // i32.extend8_s
+	I32Extend16S      = 0xC1 // i32.extend16_s
+	I64Extend8S       = 0xC2 // i64.extend8_s
+	I64Extend16S      = 0xC3 // i64.extend16_s
+	I64Extend32S      = 0xC4 // i64.extend32_s
+	RefNull           = 0xD0 // ref.null ht
+	RefIsNull         = 0xD1 // ref.is_null
+	RefFunc           = 0xD2 // ref.func x
+	RefAsNonNull      = 0xD4 // ref.as_non_null
+	BrOnNull          = 0xD5 // br_on_null l
+	BrOnNonNull       = 0xD6 // br_on_non_null l
+	PrefixFC          = 0xFC // sat conversions, bulk memory and table ops
+	PrefixSIMD        = 0xFD // simd
 )
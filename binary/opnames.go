@@ -26,6 +26,8 @@ func initOpnames() {
 	opnames[Return] = "return"
 	opnames[Call] = "call"
 	opnames[CallIndirect] = "call_indirect"
+	opnames[CallRef] = "call_ref"
+	opnames[ReturnCallRef] = "return_call_ref"
 	opnames[Drop] = "drop"
 	opnames[Select] = "select"
 	opnames[LocalGet] = "local.get"
@@ -185,9 +187,42 @@ func initOpnames() {
 	opnames[I64ReinterpretF64] = "i64.reinterpret_f64"
 	opnames[F32ReinterpretI32] = "f32.reinterpret_i32"
 	opnames[F64ReinterpretI64] = "f64.reinterpret_i64"
+	opnames[I32Extend8S] = "i32.extend8_s"
+	opnames[I32Extend16S] = "i32.extend16_s"
+	opnames[I64Extend8S] = "i64.extend8_s"
+	opnames[I64Extend16S] = "i64.extend16_s"
+	opnames[I64Extend32S] = "i64.extend32_s"
+	opnames[RefNull] = "ref.null"
+	opnames[RefIsNull] = "ref.is_null"
+	opnames[RefFunc] = "ref.func"
+	opnames[RefAsNonNull] = "ref.as_non_null"
+	opnames[BrOnNull] = "br_on_null"
+	opnames[BrOnNonNull] = "br_on_non_null"
+}
+
+// names of the instructions prefixed by 0xFC, indexed by subopcode
+var prefixFCNames = []string{
+	"i32.trunc_sat_f32_s", "i32.trunc_sat_f32_u",
+	"i32.trunc_sat_f64_s", "i32.trunc_sat_f64_u",
+	"i64.trunc_sat_f32_s", "i64.trunc_sat_f32_u",
+	"i64.trunc_sat_f64_s", "i64.trunc_sat_f64_u",
+	"memory.init", "data.drop", "memory.copy", "memory.fill",
+	"table.init", "elem.drop", "table.copy",
+	"table.grow", "table.size", "table.fill",
 }
 
 func GetOpcode(opname string) (byte, bool) {
 	opcode, found := opMap[opname]
 	return opcode, found
 }
+
+// Opnames returns the names of all instructions in opcode order.
+func Opnames() []string {
+	var names []string
+	for _, opname := range opnames {
+		if opname != "" {
+			names = append(names, opname)
+		}
+	}
+	return names
+}
@@ -5,10 +5,52 @@ import (
 	"fmt"
 	"io"
 	"math"
+	"unicode/utf8"
 )
 
 type WasmReader struct {
-	data []byte
+	data     []byte
+	features Features
+	end      int // offset of the end of data in the module
+	limiter  *limiter
+	depth    int // nesting depth of blocks
+}
+
+// offset of the next byte in the module
+func (reader *WasmReader) pos() int {
+	return reader.end - len(reader.data)
+}
+
+// returns a reader of the bytes which have just been read
+func (reader *WasmReader) subReader(data []byte) *WasmReader {
+	return &WasmReader{
+		data:     data,
+		features: reader.features,
+		end:      reader.pos(),
+		limiter:  reader.limiter,
+	}
+}
+
+func (reader *WasmReader) enterBlock() error {
+	reader.depth++
+	return reader.limiter.checkDepth(reader.depth)
+}
+
+func (reader *WasmReader) exitBlock() {
+	reader.depth--
+}
+
+// reads the length of a vector and accounts the memory it needs,
+// every element takes at least one byte
+func (reader *WasmReader) readVecLen(elemSize uintptr) (uint32, error) {
+	n, err := reader.readVarU32()
+	if err != nil {
+		return 0, err
+	}
+	if int(n) > reader.remaining() {
+		return 0, fmt.Errorf("vector length out of bounds: %d", n)
+	}
+	return n, reader.limiter.alloc(uint64(n) * uint64(elemSize))
 }
 
 func (reader *WasmReader) remaining() int {
@@ -47,6 +89,9 @@ func (reader *WasmReader) readBytes() ([]byte, error) {
 
 func (reader *WasmReader) readName() (string, error) {
 	bytes, err := reader.readBytes()
+	if err == nil && !utf8.Valid(bytes) {
+		err = fmt.Errorf("malformed UTF-8 encoding")
+	}
 	return string(bytes), err
 }
 
@@ -86,6 +131,20 @@ func (reader *WasmReader) readVarU32() (uint32, error) {
 	return uint32(n), nil
 }
 
+func (reader *WasmReader) readVarU32AsU64() (uint64, error) {
+	n, err := reader.readVarU32()
+	return uint64(n), err
+}
+
+func (reader *WasmReader) readVarU64() (uint64, error) {
+	n, w := readVarUint(reader.data, 64)
+	if w <= 0 {
+		return 0, fmt.Errorf("LEB128 error")
+	}
+	reader.data = reader.data[w:]
+	return n, nil
+}
+
 func (reader *WasmReader) readVarS32() (int32, error) {
 	n, w := readVarInt(reader.data, 32)
 	if w <= 0 {
@@ -95,6 +154,15 @@ func (reader *WasmReader) readVarS32() (int32, error) {
 	return int32(n), nil
 }
 
+func (reader *WasmReader) readVarS33() (int64, error) {
+	n, w := readVarInt(reader.data, 33)
+	if w <= 0 {
+		return 0, fmt.Errorf("LEB128 error")
+	}
+	reader.data = reader.data[w:]
+	return n, nil
+}
+
 func (reader *WasmReader) readVarS64() (int64, error) {
 	n, w := readVarInt(reader.data, 64)
 	if w <= 0 {
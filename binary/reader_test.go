@@ -28,6 +28,10 @@ func TestReads(t *testing.T) {
 	require.Equal(t, []byte{0x01, 0x02, 0x03}, discardError(reader.readBytes()))
 	require.Equal(t, "foo", discardError(reader.readName()))
 	require.Equal(t, 0, reader.remaining())
+
+	reader = WasmReader{data: []byte{0x02, 0xC3, 0x28}}
+	_, err := reader.readName()
+	require.EqualError(t, err, "malformed UTF-8 encoding")
 }
 
 func discardError(x interface{}, err error) interface{} {
@@ -1,8 +1,15 @@
 package binary
 
+// Placement of custom sections, see CustomSec.After
+const (
+	CustomSecLast  = SecCustomID // after all other sections
+	CustomSecFirst = 0xFF        // before all other sections
+)
+
 type CustomSec struct {
-	Name string
-	// TODO
+	Name  string
+	Data  []byte
+	After byte // ID of the preceding non-custom section, or CustomSecLast/CustomSecFirst
 }
 
 func readCustomSec(reader *WasmReader) (sec CustomSec, err error) {
@@ -10,7 +17,7 @@ func readCustomSec(reader *WasmReader) (sec CustomSec, err error) {
 		return
 	}
 
-	// TODO
+	sec.Data = reader.data
 	reader.data = nil
 	return
 }
@@ -0,0 +1,146 @@
+package binary
+
+import "sort"
+
+const (
+	NameSubSecModule = 0
+	NameSubSecFunc   = 1
+	NameSubSecLocal  = 2
+)
+
+type NameMap = map[uint32]string
+
+// names from the "name" custom section
+type NameSec struct {
+	ModuleName string
+	FuncNames  NameMap
+	LocalNames map[FuncIdx]NameMap
+}
+
+// returns nil if the module has no name section
+func (module Module) GetNameSec() (*NameSec, error) {
+	for _, sec := range module.CustomSecs {
+		if sec.Name == "name" {
+			names, err := DecodeNameSec(sec.Data)
+			return &names, err
+		}
+	}
+	return nil, nil
+}
+
+func DecodeNameSec(data []byte) (sec NameSec, err error) {
+	reader := &WasmReader{data: data, end: len(data)}
+	sec.FuncNames = NameMap{}
+	sec.LocalNames = map[FuncIdx]NameMap{}
+	for reader.remaining() > 0 {
+		var id byte
+		var cont []byte
+		if id, err = reader.readByte(); err != nil {
+			return
+		}
+		if cont, err = reader.readBytes(); err != nil {
+			return
+		}
+		subReader := reader.subReader(cont)
+		switch id {
+		case NameSubSecModule:
+			sec.ModuleName, err = subReader.readName()
+		case NameSubSecFunc:
+			err = readNameMap(subReader, sec.FuncNames)
+		case NameSubSecLocal:
+			err = readIndirectNameMap(subReader, sec.LocalNames)
+		default:
+			continue // unknown subsections are skipped
+		}
+		if err != nil {
+			return
+		}
+	}
+	return
+}
+
+func readNameMap(reader *WasmReader, m NameMap) error {
+	n, err := reader.readVecLen(1)
+	if err != nil {
+		return err
+	}
+	for i := uint32(0); i < n; i++ {
+		idx, err := reader.readVarU32()
+		if err != nil {
+			return err
+		}
+		if m[idx], err = reader.readName(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readIndirectNameMap(reader *WasmReader, m map[uint32]NameMap) error {
+	n, err := reader.readVecLen(1)
+	if err != nil {
+		return err
+	}
+	for i := uint32(0); i < n; i++ {
+		idx, err := reader.readVarU32()
+		if err != nil {
+			return err
+		}
+		m[idx] = NameMap{}
+		if err = readNameMap(reader, m[idx]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Encode returns the contents of the "name" custom section.
+// Empty subsections are omitted, indices are written in ascending order.
+func (sec NameSec) Encode() []byte {
+	writer := &wasmWriter{}
+	if sec.ModuleName != "" {
+		subWriter := &wasmWriter{}
+		subWriter.writeName(sec.ModuleName)
+		writer.writeByte(NameSubSecModule)
+		writer.writeBytes(subWriter.data)
+	}
+	if len(sec.FuncNames) > 0 {
+		subWriter := &wasmWriter{}
+		writeNameMap(subWriter, sec.FuncNames)
+		writer.writeByte(NameSubSecFunc)
+		writer.writeBytes(subWriter.data)
+	}
+	if len(sec.LocalNames) > 0 {
+		subWriter := &wasmWriter{}
+		indices := make([]uint32, 0, len(sec.LocalNames))
+		for idx := range sec.LocalNames {
+			indices = append(indices, idx)
+		}
+		sortIndices(indices)
+		subWriter.writeVarU32(uint32(len(indices)))
+		for _, idx := range indices {
+			subWriter.writeVarU32(idx)
+			writeNameMap(subWriter, sec.LocalNames[idx])
+		}
+		writer.writeByte(NameSubSecLocal)
+		writer.writeBytes(subWriter.data)
+	}
+	return writer.data
+}
+
+func writeNameMap(writer *wasmWriter, m NameMap) {
+	indices := make([]uint32, 0, len(m))
+	for idx := range m {
+		indices = append(indices, idx)
+	}
+	sortIndices(indices)
+	writer.writeVarU32(uint32(len(indices)))
+	for _, idx := range indices {
+		writer.writeVarU32(idx)
+		writer.writeName(m[idx])
+	}
+}
+
+func sortIndices(indices []uint32) {
+	sort.Slice(indices, func(i, j int) bool { return indices[i] < indices[j] })
+}
@@ -1,9 +1,11 @@
 package binary
 
+import "unsafe"
+
 //type TypeSec = []FuncType
 
 func readTypeSec(reader *WasmReader) (vec []FuncType, err error) {
-	n, err := reader.readVarU32()
+	n, err := reader.readVecLen(unsafe.Sizeof(FuncType{}))
 	if err != nil {
 		return nil, err
 	}
@@ -1,6 +1,9 @@
 package binary
 
-import "fmt"
+import (
+	"fmt"
+	"unsafe"
+)
 
 const (
 	ImportTagFunc   = 0
@@ -26,7 +29,7 @@ type ImportDesc struct {
 }
 
 func readImportSec(reader *WasmReader) (vec []Import, err error) {
-	n, err := reader.readVarU32()
+	n, err := reader.readVecLen(unsafe.Sizeof(Import{}))
 	if err != nil {
 		return nil, err
 	}
@@ -47,7 +50,12 @@ func readImport(reader *WasmReader) (imp Import, err error) {
 	if imp.Name, err = reader.readName(); err != nil {
 		return
 	}
-	imp.Desc, err = readImportDesc(reader)
+	if imp.Desc, err = readImportDesc(reader); err != nil {
+		return
+	}
+	if imp.Desc.Tag == ImportTagFunc {
+		err = reader.limiter.addFuncs(1)
+	}
 	return
 }
 
@@ -62,7 +70,7 @@ func readImportDesc(reader *WasmReader) (desc ImportDesc, err error) {
 	case ImportTagTable:
 		desc.Table, err = readTableType(reader)
 	case ImportTagMem:
-		desc.Mem, err = readLimits(reader)
+		desc.Mem, err = readMemType(reader)
 	case ImportTagGlobal:
 		desc.Global, err = readGlobalType(reader)
 	default:
@@ -1,3 +1,11 @@
 package binary
 
 //type FuncSec = []TypeIdx
+
+func readFuncSec(reader *WasmReader) (vec []TypeIdx, err error) {
+	if vec, err = readIndices(reader); err != nil {
+		return
+	}
+	err = reader.limiter.addFuncs(uint64(len(vec)))
+	return
+}
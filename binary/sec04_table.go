@@ -1,9 +1,11 @@
 package binary
 
+import "unsafe"
+
 //type TableSec = []TableType
 
 func readTableSec(reader *WasmReader) (vec []TableType, err error) {
-	n, err := reader.readVarU32()
+	n, err := reader.readVecLen(unsafe.Sizeof(TableType{}))
 	if err != nil {
 		return nil, err
 	}
@@ -1,16 +1,18 @@
 package binary
 
+import "unsafe"
+
 //type MemSec = []MemType
 
 func readMemSec(reader *WasmReader) (vec []MemType, err error) {
-	n, err := reader.readVarU32()
+	n, err := reader.readVecLen(unsafe.Sizeof(MemType{}))
 	if err != nil {
 		return nil, err
 	}
 
 	vec = make([]MemType, n)
 	for i := range vec {
-		if vec[i], err = readLimits(reader); err != nil {
+		if vec[i], err = readMemType(reader); err != nil {
 			return
 		}
 	}
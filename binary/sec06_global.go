@@ -1,5 +1,7 @@
 package binary
 
+import "unsafe"
+
 //type GlobalSec []Global
 
 type Global struct {
@@ -8,7 +10,7 @@ type Global struct {
 }
 
 func readGlobalSec(reader *WasmReader) (vec []Global, err error) {
-	n, err := reader.readVarU32()
+	n, err := reader.readVecLen(unsafe.Sizeof(Global{}))
 	if err != nil {
 		return nil, err
 	}
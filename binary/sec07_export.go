@@ -1,6 +1,9 @@
 package binary
 
-import "fmt"
+import (
+	"fmt"
+	"unsafe"
+)
 
 const (
 	ExportTagFunc   = 0
@@ -22,7 +25,7 @@ type ExportDesc struct {
 }
 
 func readExportSec(reader *WasmReader) (vec []Export, err error) {
-	n, err := reader.readVarU32()
+	n, err := reader.readVecLen(unsafe.Sizeof(Export{}))
 	if err != nil {
 		return nil, err
 	}
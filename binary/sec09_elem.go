@@ -1,15 +1,33 @@
 package binary
 
+import (
+	"fmt"
+	"unsafe"
+)
+
 //type ElemSec = []Elem
 
+// flags of the supported element segments: active segments of
+// table 0, and declarative segments of function indices
+const (
+	ElemFlagActive      = 0x00
+	ElemFlagDeclarative = 0x03
+)
+
 type Elem struct {
+	Flag   byte
 	Table  TableIdx
-	Offset Expr
+	Offset Expr // only for active segments
 	Init   []FuncIdx
 }
 
+// declarative segments only declare the functions for ref.func
+func (elem Elem) IsDeclarative() bool {
+	return elem.Flag == ElemFlagDeclarative
+}
+
 func readElemSec(reader *WasmReader) (vec []Elem, err error) {
-	n, err := reader.readVarU32()
+	n, err := reader.readVecLen(unsafe.Sizeof(Elem{}))
 	if err != nil {
 		return nil, err
 	}
@@ -25,12 +43,28 @@ func readElemSec(reader *WasmReader) (vec []Elem, err error) {
 }
 
 func readElem(reader *WasmReader) (elem Elem, err error) {
-	if elem.Table, err = reader.readVarU32(); err != nil {
+	flag, err := reader.readVarU32()
+	if err != nil {
 		return
 	}
-	if elem.Offset, err = readExpr(reader); err != nil {
+	switch flag {
+	case ElemFlagActive:
+		if elem.Offset, err = readExpr(reader); err != nil {
+			return
+		}
+	case ElemFlagDeclarative:
+		if err = reader.features.Check("declarative element segment",
+			FeatureReferenceTypes); err != nil {
+			return
+		}
+		if err = readZero(reader); err != nil { // elemkind funcref
+			return
+		}
+	default:
+		err = fmt.Errorf("unsupported element segment flags: %d", flag)
 		return
 	}
+	elem.Flag = byte(flag)
 	elem.Init, err = readIndices(reader)
 	return
 }
@@ -1,10 +1,15 @@
 package binary
 
-import "fmt"
+import (
+	"fmt"
+	"math"
+	"unsafe"
+)
 
 //type CodeSec = []Code
 
 type Code struct {
+	Offset uint32 // offset of body (after the size)
 	Locals []Locals
 	Expr   Expr
 }
@@ -15,10 +20,13 @@ type Locals struct {
 }
 
 func readCodeSec(reader *WasmReader) (vec []Code, err error) {
-	n, err := reader.readVarU32()
+	n, err := reader.readVecLen(unsafe.Sizeof(Code{}))
 	if err != nil {
 		return nil, err
 	}
+	if err = reader.limiter.checkFuncs(n); err != nil {
+		return nil, err
+	}
 
 	vec = make([]Code, n)
 	for i := range vec {
@@ -35,11 +43,15 @@ func readCode(reader *WasmReader) (code Code, err error) {
 		return
 	}
 
-	codeReader := WasmReader{data: contents}
-	if code.Locals, err = readLocalsVec(&codeReader); err != nil {
+	return decodeCode(reader.subReader(contents))
+}
+
+func decodeCode(codeReader *WasmReader) (code Code, err error) {
+	code.Offset = uint32(codeReader.pos())
+	if code.Locals, err = readLocalsVec(codeReader); err != nil {
 		return
 	}
-	if code.Expr, err = readExpr(&codeReader); err != nil {
+	if code.Expr, err = readExpr(codeReader); err != nil {
 		return
 	}
 	if codeReader.remaining() > 0 {
@@ -50,16 +62,24 @@ func readCode(reader *WasmReader) (code Code, err error) {
 }
 
 func readLocalsVec(reader *WasmReader) (vec []Locals, err error) {
-	n, err := reader.readVarU32()
+	n, err := reader.readVecLen(unsafe.Sizeof(Locals{}))
 	if err != nil {
 		return nil, err
 	}
 
 	vec = make([]Locals, n)
+	total := uint64(0)
 	for i := range vec {
 		if vec[i], err = readLocals(reader); err != nil {
 			return
 		}
+		total += uint64(vec[i].N)
+		if total > math.MaxUint32 {
+			return nil, fmt.Errorf("too many locals: %d", total)
+		}
+		if err = reader.limiter.checkLocals(total); err != nil {
+			return
+		}
 	}
 	return
 }
@@ -79,3 +99,11 @@ func (code Code) GetLocalCount() int {
 	}
 	return n
 }
+
+// returns location in wasm-objdump style, e.g. func[3]+0x2a
+func (code Code) Location(fIdx int, instr Instruction) string {
+	if code.Offset == 0 || instr.Offset < code.Offset {
+		return fmt.Sprintf("func[%d]", fIdx)
+	}
+	return fmt.Sprintf("func[%d]+0x%x", fIdx, instr.Offset-code.Offset)
+}
@@ -1,5 +1,7 @@
 package binary
 
+import "unsafe"
+
 //type DataSec = []Data
 
 type Data struct {
@@ -9,7 +11,7 @@ type Data struct {
 }
 
 func readDataSec(reader *WasmReader) (vec []Data, err error) {
-	n, err := reader.readVarU32()
+	n, err := reader.readVecLen(unsafe.Sizeof(Data{}))
 	if err != nil {
 		return nil, err
 	}
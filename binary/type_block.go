@@ -10,9 +10,10 @@ func readBlockType(reader *WasmReader) ([]ValType, error) {
 	} else if b == NoVal {
 		return nil, nil
 	} else {
-		if err := checkValType(b); err != nil {
+		vt, err := readValTypeOf(reader, b)
+		if err != nil {
 			return nil, err
 		}
-		return []ValType{b}, nil
+		return []ValType{vt}, nil
 	}
 }
@@ -4,26 +4,65 @@ import (
 	"fmt"
 )
 
+const (
+	LimitsFlagMax = 0x01 // max is present
+	LimitsFlag64  = 0x04 // i64 index type (memory64)
+)
+
 type Limits struct {
 	Tag byte
-	Min uint32
-	Max uint32
+	Min uint64
+	Max uint64
+}
+
+func readLimits(reader *WasmReader) (Limits, error) {
+	return readLimitsOf(reader, false)
 }
 
-func readLimits(reader *WasmReader) (limits Limits, err error) {
+func readLimitsOf(reader *WasmReader, allow64 bool) (limits Limits, err error) {
 	if limits.Tag, err = reader.readByte(); err != nil {
 		return
 	}
-	if limits.Min, err = reader.readVarU32(); err != nil {
+	switch limits.Tag {
+	case 0, LimitsFlagMax:
+	case LimitsFlag64, LimitsFlag64 | LimitsFlagMax:
+		if !allow64 {
+			err = fmt.Errorf("invalid limits flag: %d", limits.Tag)
+			return
+		}
+		if err = reader.features.Check("64-bit memory", FeatureMemory64); err != nil {
+			return
+		}
+	default:
+		err = fmt.Errorf("invalid limits flag: %d", limits.Tag)
+		return
+	}
+
+	readN := reader.readVarU32AsU64
+	if limits.Is64() {
+		readN = reader.readVarU64
+	}
+	if limits.Min, err = readN(); err != nil {
 		return
 	}
-	if limits.Tag == 1 {
-		limits.Max, err = reader.readVarU32()
+	if limits.HasMax() {
+		limits.Max, err = readN()
 	}
 	return
 }
 
+func (limits Limits) HasMax() bool {
+	return limits.Tag&LimitsFlagMax != 0
+}
+func (limits Limits) Is64() bool {
+	return limits.Tag&LimitsFlag64 != 0
+}
+
 func (limits Limits) String() string {
+	if limits.Is64() {
+		return fmt.Sprintf("{min: %d, max: %d, i64}",
+			limits.Min, limits.Max)
+	}
 	return fmt.Sprintf("{min: %d, max: %d}",
 		limits.Min, limits.Max)
 }
@@ -0,0 +1,34 @@
+package binary
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadLimits(t *testing.T) {
+	reader := WasmReader{data: []byte{0x01, 0x02, 0x03}}
+	limits, err := readLimits(&reader)
+	require.NoError(t, err)
+	require.Equal(t, Limits{Tag: 1, Min: 2, Max: 3}, limits)
+	require.False(t, limits.Is64())
+
+	reader = WasmReader{data: []byte{0x04, 0x01}}
+	_, err = readLimits(&reader)
+	require.Error(t, err)
+}
+
+func TestReadMemType64(t *testing.T) {
+	reader := WasmReader{features: AllFeatures, data: []byte{
+		0x05,
+		0x80, 0x80, 0x80, 0x80, 0x10, // 2^32
+		0x80, 0x80, 0x80, 0x80, 0x80, 0x80, 0x40, // 2^48
+	}}
+	mt, err := readMemType(&reader)
+	require.NoError(t, err)
+	require.True(t, mt.Is64())
+	require.True(t, mt.HasMax())
+	require.Equal(t, uint64(1)<<32, mt.Min)
+	require.Equal(t, uint64(1)<<48, mt.Max)
+	require.Equal(t, 0, reader.remaining())
+}
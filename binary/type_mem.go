@@ -1,8 +1,13 @@
 package binary
 
 const (
-	PageSize     = 65536 // 64KB
-	MaxPageCount = 65536 // 2^16
+	PageSize       = 65536 // 64KB
+	MaxPageCount   = 65536 // 2^16
+	MaxPageCount64 = 1 << 48
 )
 
 type MemType = Limits
+
+func readMemType(reader *WasmReader) (MemType, error) {
+	return readLimitsOf(reader, true)
+}
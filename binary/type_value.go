@@ -2,19 +2,87 @@ package binary
 
 import (
 	"fmt"
+	"unsafe"
 )
 
 const (
-	ValTypeI32 ValType = 0x7F // i32
-	ValTypeI64 ValType = 0x7E // i64
-	ValTypeF32 ValType = 0x7D // f32
-	ValTypeF64 ValType = 0x7C // f64
+	ValTypeI32       ValType = 0x7F // i32
+	ValTypeI64       ValType = 0x7E // i64
+	ValTypeF32       ValType = 0x7D // f32
+	ValTypeF64       ValType = 0x7C // f64
+	ValTypeV128      ValType = 0x7B // v128, decoded only to be rejected
+	ValTypeFuncRef   ValType = 0x70 // funcref = (ref null func)
+	ValTypeExternRef ValType = 0x6F // externref = (ref null extern)
 )
 
-type ValType = byte
+const (
+	RefNullable = 0x63 // (ref null ht)
+	RefNonNull  = 0x64 // (ref ht)
+	HeapFunc    = 0x70 // func
+	HeapExtern  = 0x6F // extern
+
+	MaxRefTypeIdx = 0xFFFFFE // largest type index a ValType can refer to
+)
+
+// The low byte of a ValType is its type code.
+// For (ref ht) and (ref null ht), ht is kept in the higher bits:
+// 0 means func, n+1 means type index n.
+type ValType uint32
+
+func RefType(nullable bool, typeIdx uint32) ValType {
+	if nullable {
+		return ValType(RefNullable) | ValType(typeIdx+1)<<8
+	}
+	return ValType(RefNonNull) | ValType(typeIdx+1)<<8
+}
+
+func (vt ValType) code() byte {
+	return byte(vt)
+}
+
+func (vt ValType) IsRef() bool {
+	switch vt.code() {
+	case HeapFunc, HeapExtern, RefNullable, RefNonNull:
+		return true
+	}
+	return false
+}
+
+func (vt ValType) IsNullable() bool {
+	return vt.code() == HeapFunc || vt.code() == HeapExtern ||
+		vt.code() == RefNullable
+}
+
+// returns the type index of a concrete heap type
+func (vt ValType) TypeIdx() (uint32, bool) {
+	if !vt.IsRef() || vt>>8 == 0 {
+		return 0, false
+	}
+	return uint32(vt>>8) - 1, true
+}
+
+func (vt ValType) AsNonNull() ValType {
+	if vt == ValTypeFuncRef {
+		return RefNonNull
+	}
+	if vt.code() == RefNullable {
+		return vt&^0xFF | RefNonNull
+	}
+	return vt
+}
+
+func (vt ValType) AsNullable() ValType {
+	if vt == RefNonNull {
+		return ValTypeFuncRef
+	}
+	if vt.code() == RefNonNull {
+		return vt&^0xFF | RefNullable
+	}
+	return vt
+}
 
 func readValTypes(reader *WasmReader) (vec []ValType, err error) {
-	n, err := reader.readVarU32()
+	n, err := reader.readVecLen(unsafe.Sizeof(ValType(0)))
 	if err != nil {
 		return nil, err
 	}
@@ -29,24 +97,74 @@ func readValTypes(reader *WasmReader) (vec []ValType, err error) {
 }
 
 func readValType(reader *WasmReader) (vt ValType, err error) {
-	if vt, err = reader.readByte(); err != nil {
+	b, err := reader.readByte()
+	if err != nil {
 		return
 	}
+	return readValTypeOf(reader, b)
+}
 
-	err = checkValType(vt)
-	return
+func readValTypeOf(reader *WasmReader, b byte) (ValType, error) {
+	vt, err := readValTypeNoCheck(reader, b)
+	if err != nil {
+		return 0, err
+	}
+	if err := reader.features.Check(vt.String(), ValTypeFeatures(vt)); err != nil {
+		return 0, err
+	}
+	if vt == ValTypeV128 {
+		return 0, fmt.Errorf("unsupported valtype: %s", vt)
+	}
+	return vt, nil
 }
 
-func checkValType(vt byte) error {
-	switch vt {
-	case ValTypeI32:
-	case ValTypeI64:
-	case ValTypeF32:
-	case ValTypeF64:
+func readValTypeNoCheck(reader *WasmReader, b byte) (ValType, error) {
+	switch vt := ValType(b); vt {
+	case ValTypeI32, ValTypeI64, ValTypeF32, ValTypeF64, ValTypeV128,
+		ValTypeFuncRef, ValTypeExternRef:
+		return vt, nil
+	case RefNullable, RefNonNull:
+		ht, err := readHeapType(reader)
+		if err != nil {
+			return 0, err
+		}
+		if ht == heapExtern {
+			if b == RefNullable {
+				return ValTypeExternRef, nil
+			}
+			return 0, fmt.Errorf("unsupported reftype: (ref extern)")
+		}
+		if ht == heapFunc {
+			if b == RefNullable {
+				return ValTypeFuncRef, nil
+			}
+			return RefNonNull, nil
+		}
+		return RefType(b == RefNullable, uint32(ht)), nil
 	default:
-		return fmt.Errorf("invalid valtype: %d", vt)
+		return 0, fmt.Errorf("invalid valtype: %d", b)
 	}
-	return nil
+}
+
+// heap types returned by readHeapType besides type indices
+const (
+	heapFunc   = -0x10 // 0x70 as s33
+	heapExtern = -0x11 // 0x6F as s33
+)
+
+// returns heapFunc, heapExtern, or type index
+func readHeapType(reader *WasmReader) (int64, error) {
+	ht, err := reader.readVarS33()
+	if err != nil {
+		return 0, err
+	}
+	if ht == heapFunc || ht == heapExtern {
+		return ht, nil
+	}
+	if ht < 0 || ht > MaxRefTypeIdx {
+		return 0, fmt.Errorf("invalid heaptype: %d", ht)
+	}
+	return ht, nil
 }
 
 func ValTypeToStr(vt ValType) string {
@@ -59,7 +177,24 @@ func ValTypeToStr(vt ValType) string {
 		return "f32"
 	case ValTypeF64:
 		return "f64"
-	default:
-		panic(fmt.Errorf("invalid valtype: %d", vt))
+	case ValTypeV128:
+		return "v128"
+	case ValTypeFuncRef:
+		return "funcref"
+	case ValTypeExternRef:
+		return "externref"
+	case RefNonNull:
+		return "(ref func)"
+	}
+	if idx, ok := vt.TypeIdx(); ok {
+		if vt.IsNullable() {
+			return fmt.Sprintf("(ref null %d)", idx)
+		}
+		return fmt.Sprintf("(ref %d)", idx)
 	}
+	panic(fmt.Errorf("invalid valtype: %d", vt))
+}
+
+func (vt ValType) String() string {
+	return ValTypeToStr(vt)
 }
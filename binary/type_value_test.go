@@ -0,0 +1,53 @@
+package binary
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadRefTypes(t *testing.T) {
+	reader := &WasmReader{features: AllFeatures, data: []byte{
+		0x70,       // funcref
+		0x63, 0x70, // (ref null func)
+		0x64, 0x70, // (ref func)
+		0x63, 0x03, // (ref null 3)
+		0x64, 0x03, // (ref 3)
+	}}
+	expected := []string{"funcref", "funcref", "(ref func)", "(ref null 3)", "(ref 3)"}
+	for _, str := range expected {
+		vt, err := readValType(reader)
+		require.NoError(t, err)
+		require.True(t, vt.IsRef())
+		require.Equal(t, str, vt.String())
+	}
+
+	vt := RefType(false, 3)
+	require.False(t, vt.IsNullable())
+	require.Equal(t, RefType(true, 3), vt.AsNullable())
+	require.Equal(t, vt, vt.AsNullable().AsNonNull())
+	idx, ok := vt.TypeIdx()
+	require.True(t, ok)
+	require.Equal(t, uint32(3), idx)
+	require.Equal(t, ValTypeFuncRef, ValType(RefNonNull).AsNullable())
+
+	_, err := readValType(&WasmReader{features: AllFeatures, data: []byte{0x64, 0x7F}})
+	require.Error(t, err)
+
+	vt, err = readValType(&WasmReader{features: AllFeatures,
+		data: []byte{0x63, 0xFE, 0xFF, 0xFF, 0x07}}) // (ref null 16777214)
+	require.NoError(t, err)
+	idx, _ = vt.TypeIdx()
+	require.Equal(t, uint32(MaxRefTypeIdx), idx)
+	_, err = readValType(&WasmReader{features: AllFeatures,
+		data: []byte{0x63, 0xFF, 0xFF, 0xFF, 0x07}}) // (ref null 16777215)
+	require.Error(t, err)
+}
+
+func TestSignatureWithRefs(t *testing.T) {
+	ft := FuncType{
+		ParamTypes:  []ValType{RefType(true, 0), ValTypeI32},
+		ResultTypes: []ValType{ValTypeFuncRef},
+	}
+	require.Equal(t, "((ref null 0),i32)->(funcref)", ft.GetSignature())
+}
@@ -0,0 +1,56 @@
+package binary
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+type wasmWriter struct {
+	data []byte
+}
+
+func (writer *wasmWriter) writeByte(b byte) {
+	writer.data = append(writer.data, b)
+}
+
+// writes a vector of bytes
+func (writer *wasmWriter) writeBytes(bytes []byte) {
+	writer.writeVarU32(uint32(len(bytes)))
+	writer.data = append(writer.data, bytes...)
+}
+
+func (writer *wasmWriter) writeName(name string) {
+	writer.writeBytes([]byte(name))
+}
+
+func (writer *wasmWriter) writeU32(n uint32) {
+	var buf [4]byte
+	binary.LittleEndian.PutUint32(buf[:], n)
+	writer.data = append(writer.data, buf[:]...)
+}
+
+func (writer *wasmWriter) writeF32(f float32) {
+	writer.writeU32(math.Float32bits(f))
+}
+
+func (writer *wasmWriter) writeF64(f float64) {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], math.Float64bits(f))
+	writer.data = append(writer.data, buf[:]...)
+}
+
+func (writer *wasmWriter) writeVarU32(n uint32) {
+	writer.data = appendVarUint(writer.data, uint64(n))
+}
+
+func (writer *wasmWriter) writeVarU64(n uint64) {
+	writer.data = appendVarUint(writer.data, n)
+}
+
+func (writer *wasmWriter) writeVarS32(n int32) {
+	writer.data = appendVarInt(writer.data, int64(n))
+}
+
+func (writer *wasmWriter) writeVarS64(n int64) {
+	writer.data = appendVarInt(writer.data, n)
+}
@@ -0,0 +1,315 @@
+package main
+
+import (
+	"bufio"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/zxh0/wasm.go/binary"
+	"github.com/zxh0/wasm.go/instance"
+	"github.com/zxh0/wasm.go/interpreter"
+	"github.com/zxh0/wasm.go/validator"
+)
+
+const debugHelp = `commands:
+  run|r FUNC [ARG...]     call exported function
+  break|b FUNC[+OFFSET]   add breakpoint, FUNC is a name, an index or func[N]
+  delete|d ID             delete breakpoint
+  info|i                  list breakpoints
+  continue|c              continue until breakpoint
+  step|s                  step into calls
+  next|n                  step over calls
+  finish|f                step out of current function
+  kill|k                  abort running function
+  backtrace|bt            print call stack
+  locals|l [FRAME]        print params and locals
+  stack                   print operand stack of current function
+  globals|g               print globals
+  x ADDR [N]              dump N bytes of memory (default 64)
+  quit|q                  exit`
+
+type debugREPL struct {
+	d      *interpreter.Debugger
+	module binary.Module
+	out    io.Writer
+}
+
+func debugWasm(filename string, features binary.Features) error {
+	module, err := binary.DecodeFileWithOptions(filename,
+		binary.DecodeOptions{Features: features})
+	if err != nil {
+		return err
+	}
+	if err, _ = validator.ValidateWithOptions(module,
+		validator.Options{Features: features}); err != nil {
+		return err
+	}
+
+	mm := map[string]instance.Instance{"env": newTestEnv()}
+	d, err := interpreter.NewDebugger(module, mm)
+	if err != nil {
+		return err
+	}
+	r := &debugREPL{d: d, module: module, out: os.Stdout}
+	r.run(os.Stdin)
+	return nil
+}
+
+func (r *debugREPL) run(in io.Reader) {
+	fmt.Fprintln(r.out, `type "help" for commands`)
+	scanner := bufio.NewScanner(in)
+	for {
+		fmt.Fprint(r.out, "(wdb) ")
+		if !scanner.Scan() {
+			break
+		}
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if fields[0] == "quit" || fields[0] == "q" {
+			break
+		}
+		if err := r.exec(fields[0], fields[1:]); err != nil {
+			fmt.Fprintln(r.out, "error:", err)
+		}
+	}
+	if r.d.Running() {
+		_, _ = r.d.Kill()
+	}
+}
+
+func (r *debugREPL) exec(cmd string, args []string) error {
+	switch cmd {
+	case "help", "h":
+		fmt.Fprintln(r.out, debugHelp)
+	case "run", "r":
+		return r.call(args)
+	case "break", "b":
+		return r.addBreakpoint(args)
+	case "delete", "d":
+		if len(args) != 1 {
+			return fmt.Errorf("usage: delete ID")
+		}
+		id, err := strconv.Atoi(args[0])
+		if err != nil {
+			return err
+		}
+		return r.d.Delete(id)
+	case "info", "i":
+		for _, bp := range r.d.Breakpoints() {
+			fmt.Fprintf(r.out, "%d: %s\n", bp.ID, r.getBreakpointStr(bp))
+		}
+	case "continue", "c":
+		return r.resume(r.d.Continue)
+	case "step", "s":
+		return r.resume(r.d.StepIn)
+	case "next", "n":
+		return r.resume(r.d.StepOver)
+	case "finish", "f":
+		return r.resume(r.d.StepOut)
+	case "kill", "k":
+		return r.resume(r.d.Kill)
+	case "backtrace", "bt":
+		for i, frame := range r.d.Frames() {
+			fmt.Fprintf(r.out, "#%d %s %s: %s\n", i, frame.Location,
+				frame.Name, getInstrStr(frame.Instr))
+		}
+	case "locals", "l":
+		return r.printLocals(args)
+	case "stack":
+		for i, val := range r.d.Stack() {
+			fmt.Fprintf(r.out, "%d: 0x%x (%d)\n", i, val, int64(val))
+		}
+	case "globals", "g":
+		for i, v := range r.d.Globals() {
+			fmt.Fprintf(r.out, "%d %s: %s = %s\n", i, v.Name, v.Value.Type, v.Value)
+		}
+	case "x":
+		return r.dumpMemory(args)
+	default:
+		return fmt.Errorf("unknown command %q, try help", cmd)
+	}
+	return nil
+}
+
+func (r *debugREPL) call(args []string) error {
+	if len(args) == 0 {
+		return fmt.Errorf("usage: run FUNC [ARG...]")
+	}
+	ft, ok := getExportFuncType(r.module, args[0])
+	if !ok {
+		return fmt.Errorf("function not found: %s", args[0])
+	}
+	if len(ft.ParamTypes) != len(args)-1 {
+		return fmt.Errorf("%s expects %d args", args[0], len(ft.ParamTypes))
+	}
+	callArgs := make([]interface{}, len(ft.ParamTypes))
+	for i, vt := range ft.ParamTypes {
+		arg, err := parseArg(vt, args[i+1])
+		if err != nil {
+			return err
+		}
+		callArgs[i] = arg
+	}
+	return r.resume(func() (*interpreter.Stop, error) {
+		return r.d.Call(args[0], callArgs...)
+	})
+}
+
+func (r *debugREPL) resume(f func() (*interpreter.Stop, error)) error {
+	stop, err := f()
+	if err != nil {
+		return err
+	}
+	switch stop.Reason {
+	case interpreter.StopExit:
+		if stop.Err != nil {
+			fmt.Fprintln(r.out, "trapped:", stop.Err)
+		} else {
+			fmt.Fprintln(r.out, "returned:", stop.Result)
+		}
+		return nil
+	case interpreter.StopBreakpoint:
+		fmt.Fprintf(r.out, "breakpoint %d, ", stop.Breakpoint)
+	}
+	frame := r.d.Frames()[0]
+	fmt.Fprintf(r.out, "%s %s: %s\n", frame.Location, frame.Name, getInstrStr(frame.Instr))
+	return nil
+}
+
+// FUNC or FUNC+OFFSET
+func (r *debugREPL) addBreakpoint(args []string) error {
+	if len(args) != 1 {
+		return fmt.Errorf("usage: break FUNC[+OFFSET]")
+	}
+	name, offset := args[0], ""
+	if i := strings.LastIndexByte(name, '+'); i > 0 {
+		name, offset = name[:i], name[i+1:]
+	}
+	if strings.HasPrefix(name, "func[") && strings.HasSuffix(name, "]") {
+		name = name[5 : len(name)-1]
+	}
+	fIdx, ok := r.d.LookupFunc(name)
+	if !ok {
+		return fmt.Errorf("function not found: %s", name)
+	}
+
+	var bp interpreter.Breakpoint
+	var err error
+	if offset == "" {
+		bp, err = r.d.BreakFunc(fIdx)
+	} else {
+		var n uint64
+		if n, err = strconv.ParseUint(offset, 0, 32); err != nil {
+			return err
+		}
+		bp, err = r.d.BreakAt(fIdx, int(n))
+	}
+	if err == nil {
+		fmt.Fprintf(r.out, "breakpoint %d: %s\n", bp.ID, r.getBreakpointStr(bp))
+	}
+	return err
+}
+
+func (r *debugREPL) getBreakpointStr(bp interpreter.Breakpoint) string {
+	s := fmt.Sprintf("func[%d]", bp.FuncIdx)
+	if bp.Offset >= 0 {
+		s += fmt.Sprintf("+0x%x", bp.Offset)
+	}
+	if name := r.d.FuncName(bp.FuncIdx); name != "" {
+		s += " " + name
+	}
+	return s
+}
+
+func (r *debugREPL) printLocals(args []string) error {
+	frame := 0
+	if len(args) > 0 {
+		var err error
+		if frame, err = strconv.Atoi(args[0]); err != nil {
+			return err
+		}
+	}
+	locals, err := r.d.Locals(frame)
+	if err != nil {
+		return err
+	}
+	for i, v := range locals {
+		fmt.Fprintf(r.out, "%d %s: %s = %s\n", i, v.Name, v.Value.Type, v.Value)
+	}
+	return nil
+}
+
+func (r *debugREPL) dumpMemory(args []string) error {
+	if len(args) == 0 || len(args) > 2 {
+		return fmt.Errorf("usage: x ADDR [N]")
+	}
+	addr, err := strconv.ParseUint(args[0], 0, 64)
+	if err != nil {
+		return err
+	}
+	n := 64
+	if len(args) == 2 {
+		if n, err = strconv.Atoi(args[1]); err != nil {
+			return err
+		}
+	}
+	data, err := r.d.ReadMemory(addr, n)
+	if err != nil {
+		return err
+	}
+	fmt.Fprint(r.out, hex.Dump(data))
+	return nil
+}
+
+func getExportFuncType(m binary.Module, name string) (binary.FuncType, bool) {
+	for _, exp := range m.ExportSec {
+		if exp.Name == name && exp.Desc.Tag == binary.ExportTagFunc {
+			fIdx := int(exp.Desc.Idx)
+			for _, imp := range m.ImportSec {
+				if imp.Desc.Tag == binary.ImportTagFunc {
+					if fIdx == 0 {
+						return m.TypeSec[imp.Desc.FuncType], true
+					}
+					fIdx--
+				}
+			}
+			return m.TypeSec[m.FuncSec[fIdx]], true
+		}
+	}
+	return binary.FuncType{}, false
+}
+
+func parseArg(vt binary.ValType, s string) (interface{}, error) {
+	switch vt {
+	case binary.ValTypeI32:
+		n, err := strconv.ParseInt(s, 0, 32)
+		return int32(n), err
+	case binary.ValTypeI64:
+		n, err := strconv.ParseInt(s, 0, 64)
+		return n, err
+	case binary.ValTypeF32:
+		f, err := strconv.ParseFloat(s, 32)
+		return float32(f), err
+	case binary.ValTypeF64:
+		return strconv.ParseFloat(s, 64)
+	}
+	return nil, fmt.Errorf("unsupported param type: %s", vt)
+}
+
+// opname and immediates
+func getInstrStr(instr binary.Instruction) string {
+	switch args := instr.Args.(type) {
+	case nil, binary.BlockArgs, binary.IfArgs:
+		return instr.String()
+	case binary.BrTableArgs:
+		return fmt.Sprintf("%s %v %d", instr, args.Labels, args.Default)
+	default:
+		return fmt.Sprintf("%s %v", instr, args)
+	}
+}
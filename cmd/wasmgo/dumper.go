@@ -20,6 +20,7 @@ func newDumper(module binary.Module) *dumper {
 
 func (d *dumper) dump() {
 	fmt.Printf("Version: 0x%02x\n", d.module.Version)
+	d.dumpSections()
 	d.dumpTypeSec()
 	d.dumpImportSec()
 	d.dumpFuncSec()
@@ -34,6 +35,21 @@ func (d *dumper) dump() {
 	d.dumpCustomSec()
 }
 
+var secNames = []string{"Custom", "Type", "Import", "Function", "Table",
+	"Memory", "Global", "Export", "Start", "Elem", "Code", "Data"}
+
+func (d *dumper) dumpSections() {
+	fmt.Printf("Sections[%d]:\n", len(d.module.Sections))
+	for _, sec := range d.module.Sections {
+		name := "unknown"
+		if int(sec.ID) < len(secNames) {
+			name = secNames[sec.ID]
+		}
+		fmt.Printf("  %-8s start=0x%08x end=0x%08x (size=0x%08x)\n",
+			name, sec.Offset, sec.Offset+sec.Size, sec.Size)
+	}
+}
+
 func (d *dumper) dumpTypeSec() {
 	fmt.Printf("Type[%d]:\n", len(d.module.TypeSec))
 	for i, ft := range d.module.TypeSec {
@@ -124,14 +140,18 @@ func (d *dumper) dumpStartSec() {
 func (d *dumper) dumpElemSec() {
 	fmt.Printf("Element[%d]:\n", len(d.module.ElemSec))
 	for i, elem := range d.module.ElemSec {
-		fmt.Printf("  elem[%d]: table=%d\n", i, elem.Table) // TODO
+		if elem.IsDeclarative() {
+			fmt.Printf("  elem[%d]: declarative\n", i)
+		} else {
+			fmt.Printf("  elem[%d]: table=%d\n", i, elem.Table) // TODO
+		}
 	}
 }
 
 func (d *dumper) dumpCodeSec() {
 	fmt.Printf("Code[%d]:\n", len(d.module.CodeSec))
 	for i, code := range d.module.CodeSec {
-		fmt.Printf("  func[%d]: locals=[", d.importedFuncCount+i) // TODO
+		fmt.Printf("  %06x func[%d]: locals=[", code.Offset, d.importedFuncCount+i) // TODO
 		if len(code.Locals) > 0 {
 			for i, locals := range code.Locals {
 				if i > 0 {
@@ -156,26 +176,29 @@ func (d *dumper) dumpDataSec() {
 func (d *dumper) dumpCustomSec() {
 	fmt.Printf("Custom[%d]:\n", len(d.module.CustomSecs))
 	for i, cs := range d.module.CustomSecs {
-		fmt.Printf("  custom[%d]: name=%s\n", i, cs.Name) // TODO
+		fmt.Printf("  custom[%d]: name=%s, size=%d\n", i, cs.Name, len(cs.Data))
 	}
 }
 
+// each instruction is prefixed with its offset, like wasm-objdump -d
 func dumpExpr(indentation string, expr binary.Expr) {
 	for _, instr := range expr {
 		switch instr.Opcode {
 		case binary.Block, binary.Loop:
-			fmt.Printf("%s%s\n", indentation, instr.GetOpname())
+			fmt.Printf("%06x:%s%s\n", instr.Offset, indentation, instr.GetOpname())
 			dumpExpr(indentation+"  ", instr.Args.(binary.BlockArgs).Instrs)
-			fmt.Printf("%s%s\n", indentation, "end")
+			fmt.Printf("       %s%s\n", indentation, "end")
 		case binary.If:
-			fmt.Printf("%s%s\n", indentation, "if")
+			fmt.Printf("%06x:%s%s\n", instr.Offset, indentation, "if")
 			dumpExpr(indentation+"  ", instr.Args.(binary.IfArgs).Instrs1)
-			fmt.Printf("%s%s\n", indentation, "else")
+			fmt.Printf("       %s%s\n", indentation, "else")
 			dumpExpr(indentation+"  ", instr.Args.(binary.IfArgs).Instrs2)
-			fmt.Printf("%s%s\n", indentation, "end")
+			fmt.Printf("       %s%s\n", indentation, "end")
 		default:
 			if instr.Args != nil {
-				fmt.Printf("%s%s %v\n", indentation, instr.GetOpname(), instr.Args)
+				fmt.Printf("%06x:%s%s %v\n", instr.Offset, indentation, instr.GetOpname(), instr.Args)
+			} else {
+				fmt.Printf("%06x:%s%s\n", instr.Offset, indentation, instr.GetOpname())
 			}
 		}
 	}
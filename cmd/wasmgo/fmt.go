@@ -0,0 +1,102 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+
+	"github.com/zxh0/wasm.go/text"
+)
+
+type fmtOptions struct {
+	style int
+	write bool // rewrite the files
+	diff  bool // print diffs
+}
+
+func formatFiles(filenames []string, opts fmtOptions) error {
+	for _, filename := range filenames {
+		if err := formatFile(filename, opts); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func formatFile(filename string, opts fmtOptions) error {
+	src, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return err
+	}
+	out, err := text.Format(filename, string(src),
+		text.FormatOptions{Style: opts.style})
+	if err != nil {
+		return printDiagnostics(err)
+	}
+
+	if !opts.write && !opts.diff {
+		_, err = os.Stdout.WriteString(out)
+		return err
+	}
+	if out == string(src) {
+		return nil
+	}
+	if opts.diff {
+		data, err := diff(filename, src, []byte(out))
+		if err != nil {
+			return err
+		}
+		if _, err := os.Stdout.Write(data); err != nil {
+			return err
+		}
+	}
+	if opts.write {
+		fi, err := os.Stat(filename)
+		if err != nil {
+			return err
+		}
+		return ioutil.WriteFile(filename, []byte(out), fi.Mode().Perm())
+	}
+	return nil
+}
+
+// runs diff -u like gofmt -d
+func diff(filename string, b1, b2 []byte) ([]byte, error) {
+	f1, err := writeTempFile(b1)
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(f1)
+	f2, err := writeTempFile(b2)
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(f2)
+
+	data, err := exec.Command("diff", "-u",
+		"-L", filename+".orig", "-L", filename, f1, f2).CombinedOutput()
+	if len(data) > 0 { // exit status 1 if the files differ
+		return data, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("diff: %v", err)
+	}
+	return data, nil
+}
+
+func writeTempFile(data []byte) (string, error) {
+	f, err := ioutil.TempFile("", "wasmgo-fmt")
+	if err != nil {
+		return "", err
+	}
+	_, err = f.Write(data)
+	if err1 := f.Close(); err == nil {
+		err = err1
+	}
+	if err != nil {
+		os.Remove(f.Name())
+		return "", err
+	}
+	return f.Name(), nil
+}
@@ -1,11 +1,12 @@
 package main
 
 import (
-	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"log"
 	"os"
+	"path/filepath"
+	"runtime"
 	"strings"
 
 	"github.com/urfave/cli/v2"
@@ -13,6 +14,7 @@ import (
 	"github.com/zxh0/wasm.go/binary"
 	"github.com/zxh0/wasm.go/instance"
 	"github.com/zxh0/wasm.go/interpreter"
+	"github.com/zxh0/wasm.go/lsp"
 	"github.com/zxh0/wasm.go/text"
 	"github.com/zxh0/wasm.go/validator"
 )
@@ -23,6 +25,9 @@ const appHelpTemplate = `NAME:
 USAGE:
    {{if .UsageText}}{{.UsageText}}{{else}}{{.HelpName}} [options] {{.ArgsUsage}}{{end}}{{if .VisibleCommands}}
 
+COMMANDS:{{range .VisibleCommands}}
+   {{join .Names ", "}}{{"\t"}}{{.Usage}}{{end}}
+
 OPTIONS:
    {{range $index, $option := .VisibleFlags}}{{if $index}}
    {{end}}{{$option}}{{end}}{{end}}
@@ -35,42 +40,72 @@ const (
 	flagNameExec    = "exec"
 	flagNameCompile = "compile"
 	flagNameTest    = "test"
+	flagNameWat     = "wat"
+	flagNameFolded  = "folded"
+	flagNameAnnots  = "annotations"
+	flagNameOutput  = "output"
+	flagNameValid   = "validate"
+	flagNameNames   = "debug-names"
 )
 
 // wasmgo             file.wasm # exec
 // wasmgo -A|-aot     file.wasm
 // wasmgo -C|-check   file.wasm
 // wasmgo -D|-dump    file.wasm
-// wasmgo -K|-compile file.wat
+// wasmgo -K|-compile [-o out.wasm] [--validate] [--debug-names] file.wat
 // wasmgo -T|-test    file.wast
+// wasmgo -W|-wat     [--folded] [--annotations] file.wasm
+// wasmgo wast2json [-o out.json] file.wast
+// wasmgo test [-j N] [--junit report.xml] [--json report.json] dir|file.wast...
+// wasmgo fmt [-w] [-d] [--flat|--folded] file.wat|file.wast...
+// wasmgo lsp
+// wasmgo run [--cpuprofile out.prof] file.wasm
+// wasmgo debug file.wasm
+// wasmgo [run|debug] --enable-tail-call --disable-memory64 ...
 func main() {
 	app := &cli.App{
 		Version:   "0.1.0",
 		Usage:     "Wasm.go CLI",
 		ArgsUsage: "[file]",
-		Flags: []cli.Flag{
+		Flags: append([]cli.Flag{
 			boolFlag(flagNameAOT, "A", "aot compile .wasm file", false),
 			boolFlag(flagNameCheck, "C", "check .wasm file", false),
 			boolFlag(flagNameDump, "D", "dump .wasm file", false),
 			boolFlag(flagNameExec, "E", "execute .wasm file", true),
 			boolFlag(flagNameCompile, "K", "compile .wat file", false),
 			boolFlag(flagNameTest, "T", "test .wast file", false),
-		},
+			boolFlag(flagNameWat, "W", "print .wasm file as .wat", false),
+			&cli.BoolFlag{Name: flagNameFolded, Usage: "print folded instructions"},
+			&cli.BoolFlag{Name: flagNameAnnots,
+				Usage: "print custom sections and original names as annotations"},
+			&cli.StringFlag{Name: flagNameOutput, Aliases: []string{"o"},
+				Usage: "output file of compile"},
+			&cli.BoolFlag{Name: flagNameValid, Usage: "validate compiled module"},
+			&cli.BoolFlag{Name: flagNameNames, Usage: "emit name section from $identifiers"},
+		}, featureFlags()...),
+		Commands:              []*cli.Command{testCommand(), wast2jsonCommand(), fmtCommand(), lspCommand(), runCommand(), debugCommand()},
 		CustomAppHelpTemplate: appHelpTemplate,
 		Action: func(ctx *cli.Context) error {
 			filename := ctx.Args().Get(0)
+			features := getFeatures(ctx)
 			if ctx.Bool(flagNameAOT) {
-				return aotWasm(filename)
+				return aotWasm(filename, features)
 			} else if ctx.Bool(flagNameCheck) {
-				return checkWasm(filename)
+				return checkWasm(filename, features)
 			} else if ctx.Bool(flagNameDump) {
-				return dumpWasm(filename)
+				return dumpWasm(filename, features)
 			} else if ctx.Bool(flagNameCompile) {
-				return compileWat(filename)
+				return compileWat(filename, ctx.String(flagNameOutput), features,
+					ctx.Bool(flagNameValid), ctx.Bool(flagNameNames))
 			} else if ctx.Bool(flagNameTest) {
-				return testWast(filename)
+				return testWast(filename, features)
+			} else if ctx.Bool(flagNameWat) {
+				return printWat(filename, features, text.PrintOptions{
+					Folded:      ctx.Bool(flagNameFolded),
+					Annotations: ctx.Bool(flagNameAnnots),
+				})
 			} else if strings.HasSuffix(filename, ".wasm") {
-				return execWasm(filename)
+				return execWasm(filename, features, "")
 			} else if strings.HasSuffix(filename, ".so") {
 				return execAOT(filename)
 			} else {
@@ -84,6 +119,125 @@ func main() {
 	}
 }
 
+func testCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "test",
+		Usage:     "run .wast files, directories are searched recursively",
+		ArgsUsage: "dir|file.wast...",
+		Flags: append([]cli.Flag{
+			&cli.IntFlag{Name: "parallel", Aliases: []string{"j"},
+				Value: runtime.NumCPU(), Usage: "number of files to run in parallel"},
+			&cli.StringFlag{Name: "junit", Usage: "write JUnit XML report to `FILE`"},
+			&cli.StringFlag{Name: "json", Usage: "write JSON report to `FILE`"},
+		}, featureFlags()...),
+		Action: func(ctx *cli.Context) error {
+			if ctx.NArg() == 0 {
+				return fmt.Errorf("no .wast file or directory given")
+			}
+			return runTests(ctx.Args().Slice(), testOptions{
+				parallel: ctx.Int("parallel"),
+				junit:    ctx.String("junit"),
+				json:     ctx.String("json"),
+				features: getFeatures(ctx),
+			}, os.Stdout)
+		},
+	}
+}
+
+func wast2jsonCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "wast2json",
+		Usage:     "convert .wast file to JSON and .wasm files like WABT's wast2json",
+		ArgsUsage: "file.wast",
+		Flags: []cli.Flag{
+			&cli.StringFlag{Name: "output", Aliases: []string{"o"},
+				Usage: "output JSON `FILE`, module files are written next to it"},
+		},
+		Action: func(ctx *cli.Context) error {
+			if ctx.NArg() != 1 {
+				return fmt.Errorf("expected one .wast file")
+			}
+			return wast2json(ctx.Args().Get(0), ctx.String("output"))
+		},
+	}
+}
+
+func fmtCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "fmt",
+		Usage:     "reformat .wat and .wast files, comments and literals are kept",
+		ArgsUsage: "file.wat|file.wast...",
+		Flags: []cli.Flag{
+			&cli.BoolFlag{Name: "w", Usage: "write result to source files instead of stdout"},
+			&cli.BoolFlag{Name: "d", Usage: "display diffs instead of rewriting files"},
+			&cli.BoolFlag{Name: "flat", Usage: "unfold folded instructions"},
+			&cli.BoolFlag{Name: flagNameFolded, Usage: "fold instructions"},
+		},
+		Action: func(ctx *cli.Context) error {
+			if ctx.NArg() == 0 {
+				return fmt.Errorf("no .wat or .wast file given")
+			}
+			style := text.StyleKeep
+			if ctx.Bool("flat") && ctx.Bool(flagNameFolded) {
+				return fmt.Errorf("--flat and --folded are exclusive")
+			} else if ctx.Bool("flat") {
+				style = text.StyleFlat
+			} else if ctx.Bool(flagNameFolded) {
+				style = text.StyleFolded
+			}
+			return formatFiles(ctx.Args().Slice(), fmtOptions{
+				style: style,
+				write: ctx.Bool("w"),
+				diff:  ctx.Bool("d"),
+			})
+		},
+	}
+}
+
+func lspCommand() *cli.Command {
+	return &cli.Command{
+		Name:  "lsp",
+		Usage: "run language server for .wat and .wast files over stdio",
+		Action: func(ctx *cli.Context) error {
+			return lsp.Serve(os.Stdin, os.Stdout)
+		},
+	}
+}
+
+func runCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "run",
+		Usage:     "execute main function of .wasm file",
+		ArgsUsage: "file.wasm",
+		Flags: append([]cli.Flag{
+			&cli.StringFlag{Name: "cpuprofile",
+				Usage: "write pprof profile of executed instructions to file"},
+		}, featureFlags()...),
+		Action: func(ctx *cli.Context) error {
+			if ctx.NArg() != 1 {
+				return fmt.Errorf("no .wasm file given")
+			}
+			return execWasm(ctx.Args().First(), getFeatures(ctx),
+				ctx.String("cpuprofile"))
+		},
+	}
+}
+
+func debugCommand() *cli.Command {
+	return &cli.Command{
+		Name:      "debug",
+		Usage:     "debug .wasm file interactively",
+		ArgsUsage: "file.wasm",
+		Flags:     featureFlags(),
+		Action: func(ctx *cli.Context) error {
+			if ctx.NArg() != 1 {
+				return fmt.Errorf("no .wasm file given")
+			}
+			return debugWasm(ctx.Args().First(), getFeatures(ctx))
+		},
+	}
+}
+
 func boolFlag(name, alias, usage string, value bool) cli.Flag {
 	return &cli.BoolFlag{
 		Name:    name,
@@ -93,9 +247,40 @@ func boolFlag(name, alias, usage string, value bool) cli.Flag {
 	}
 }
 
-func aotWasm(filename string) error {
+func featureFlags() []cli.Flag {
+	var flags []cli.Flag
+	for _, name := range binary.FeatureNames() {
+		flags = append(flags,
+			&cli.BoolFlag{Name: "enable-" + name, Usage: "enable " + name},
+			&cli.BoolFlag{Name: "disable-" + name, Usage: "disable " + name})
+	}
+	return flags
+}
+
+// feature flags may be given before and after the command,
+// the latter take precedence
+func getFeatures(ctx *cli.Context) binary.Features {
+	features := binary.DefaultFeatures
+	lineage := ctx.Lineage()
+	for i := len(lineage) - 1; i >= 0; i-- {
+		c := lineage[i]
+		for _, name := range binary.FeatureNames() {
+			f, _ := binary.ParseFeature(name)
+			if c.Bool("enable-" + name) {
+				features |= f
+			}
+			if c.Bool("disable-" + name) {
+				features &^= f
+			}
+		}
+	}
+	return features
+}
+
+func aotWasm(filename string, features binary.Features) error {
 	//fmt.Println("AOT " + filename)
-	module, err := binary.DecodeFile(filename)
+	module, err := binary.DecodeFileWithOptions(filename,
+		binary.DecodeOptions{Features: features})
 	if err != nil {
 		return err
 	}
@@ -104,21 +289,24 @@ func aotWasm(filename string) error {
 	return nil
 }
 
-func checkWasm(filename string) error {
+func checkWasm(filename string, features binary.Features) error {
 	fmt.Println("check " + filename)
-	module, err := binary.DecodeFile(filename)
+	module, err := binary.DecodeFileWithOptions(filename,
+		binary.DecodeOptions{Features: features})
 	if err != nil {
 		return err
 	}
 
-	err, _ = validator.Validate(module)
+	err, _ = validator.ValidateWithOptions(module,
+		validator.Options{Features: features})
 	return err
 }
 
-func dumpWasm(filename string) error {
+func dumpWasm(filename string, features binary.Features) error {
 	fmt.Printf("file: \n  %s\n\n", filename)
 
-	module, err := binary.DecodeFile(filename)
+	module, err := binary.DecodeFileWithOptions(filename,
+		binary.DecodeOptions{Features: features})
 	if err != nil {
 		return err
 	}
@@ -127,53 +315,130 @@ func dumpWasm(filename string) error {
 	return nil
 }
 
-func execWasm(filename string) error {
-	fmt.Println("exec " + filename)
-	data, err := ioutil.ReadFile(filename)
+func printWat(filename string, features binary.Features, opts text.PrintOptions) error {
+	module, err := binary.DecodeFileWithOptions(filename,
+		binary.DecodeOptions{Features: features})
 	if err != nil {
 		return err
 	}
 
-	module, err := binary.Decode(data)
+	return text.PrintWithOptions(module, os.Stdout, opts)
+}
+
+func execWasm(filename string, features binary.Features, cpuProfile string) error {
+	fmt.Println("exec " + filename)
+	data, err := ioutil.ReadFile(filename)
 	if err != nil {
 		return err
 	}
 
-	mm := map[string]instance.Instance{"env": newTestEnv()}
-	vm, err := interpreter.NewInstance(module, mm)
+	module, err := binary.DecodeWithOptions(data,
+		binary.DecodeOptions{Features: features})
 	if err != nil {
 		return err
 	}
+	if err, _ = validator.ValidateWithOptions(module,
+		validator.Options{Features: features}); err != nil {
+		return err
+	}
+
+	var opts interpreter.Options
+	var profiler *interpreter.Profiler
+	if cpuProfile != "" {
+		profiler = interpreter.NewProfiler(module)
+		opts.Listener = profiler
+	}
 
-	//ni.mem, _ = vm.GetMemory("")
-	_, err = vm.CallFunc("main")
+	mm := map[string]instance.Instance{"env": newTestEnv()}
+	vm, err := interpreter.NewInstanceWithOptions(module, mm, opts)
+	if err == nil {
+		//ni.mem, _ = vm.GetMemory("")
+		_, err = vm.CallFunc("main")
+	}
+	if profiler != nil {
+		if _err := writeProfile(profiler, cpuProfile); err == nil {
+			err = _err
+		}
+	}
 	return err
 }
 
-func compileWat(filename string) error {
-	fmt.Println("compile " + filename)
-	m, err := text.CompileModuleFile(filename)
+func writeProfile(profiler *interpreter.Profiler, filename string) error {
+	f, err := os.Create(filename)
 	if err != nil {
 		return err
 	}
+	if err := profiler.WriteProfile(f); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
 
-	// TODO
-	bytes, err := json.MarshalIndent(m, "", "  ")
+func compileWat(filename, output string, features binary.Features,
+	validate, names bool) error {
+
+	fmt.Println("compile " + filename)
+	m, err := text.CompileModuleFileWithOptions(filename,
+		text.CompileOptions{Names: names})
 	if err != nil {
-		return err
+		return printDiagnostics(err)
+	}
+	if validate {
+		if err, _ = validator.ValidateWithOptions(*m,
+			validator.Options{Features: features}); err != nil {
+			return err
+		}
 	}
 
-	fmt.Println(string(bytes))
-	return nil
+	if output == "" {
+		output = strings.TrimSuffix(filename, filepath.Ext(filename)) + ".wasm"
+	}
+	return ioutil.WriteFile(output, binary.Encode(*m), 0644)
+}
+
+// compile errors are printed to stderr
+func printDiagnostics(err error) error {
+	if diags, ok := err.(text.Diagnostics); ok {
+		for _, d := range diags {
+			fmt.Fprintln(os.Stderr, d.Error())
+		}
+		if len(diags) == 1 {
+			return fmt.Errorf("1 error")
+		}
+		return fmt.Errorf("%d errors", len(diags))
+	}
+	return err
 }
 
-func testWast(filename string) error {
+func testWast(filename string, features binary.Features) error {
 	fmt.Println("test " + filename)
 	s, err := text.CompileScriptFile(filename)
 	if err != nil {
 		return err
 	}
-	return newWastTester(s).test()
+	return newWastTester(s, filepath.Dir(filename), features).test()
+}
+
+func wast2json(filename, output string) error {
+	s, err := text.CompileScriptFile(filename)
+	if err != nil {
+		return err
+	}
+	if output == "" {
+		output = strings.TrimSuffix(filename, filepath.Ext(filename)) + ".json"
+	}
+	data, files, err := text.ScriptToJSON(s, filename, output)
+	if err != nil {
+		return err
+	}
+	for name, fileData := range files {
+		err := ioutil.WriteFile(filepath.Join(filepath.Dir(output), name), fileData, 0644)
+		if err != nil {
+			return err
+		}
+	}
+	return ioutil.WriteFile(output, data, 0644)
 }
 
 func execAOT(filename string) error {
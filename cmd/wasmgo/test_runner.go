@@ -0,0 +1,274 @@
+package main
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/zxh0/wasm.go/binary"
+	"github.com/zxh0/wasm.go/text"
+)
+
+type testOptions struct {
+	parallel int
+	junit    string // JUnit XML report file
+	json     string // JSON report file
+	features binary.Features
+}
+
+type fileReport struct {
+	File     string          `json:"file"`
+	Passed   int             `json:"passed"`
+	Failed   int             `json:"failed"`
+	Skipped  int             `json:"skipped"`
+	Time     float64         `json:"time"` // seconds
+	Error    string          `json:"error,omitempty"`
+	Failures []failureReport `json:"failures,omitempty"`
+	results  []wastResult
+}
+
+type failureReport struct {
+	Cmd     string `json:"cmd"`
+	Message string `json:"message"`
+}
+
+// runs all .wast files in paths (files or directories)
+func runTests(paths []string, opts testOptions, w io.Writer) error {
+	files, err := findWastFiles(paths)
+	if err != nil {
+		return err
+	}
+
+	reports := make([]fileReport, len(files))
+	if opts.parallel < 1 {
+		opts.parallel = 1
+	}
+	var wg sync.WaitGroup
+	idxCh := make(chan int)
+	for i := 0; i < opts.parallel; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for idx := range idxCh {
+				reports[idx] = runTestFile(files[idx], opts.features)
+			}
+		}()
+	}
+	for i := range files {
+		idxCh <- i
+	}
+	close(idxCh)
+	wg.Wait()
+
+	failedFiles := printReports(reports, w)
+	if opts.junit != "" {
+		if err := writeJUnitReport(reports, opts.junit); err != nil {
+			return err
+		}
+	}
+	if opts.json != "" {
+		if err := writeJSONReport(reports, opts.json); err != nil {
+			return err
+		}
+	}
+	if failedFiles > 0 {
+		return fmt.Errorf("%d of %d files failed", failedFiles, len(files))
+	}
+	return nil
+}
+
+func findWastFiles(paths []string) ([]string, error) {
+	var files []string
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+		if !info.IsDir() {
+			files = append(files, path)
+			continue
+		}
+		var dirFiles []string
+		err = filepath.Walk(path, func(file string, info os.FileInfo, err error) error {
+			if err == nil && !info.IsDir() && filepath.Ext(file) == ".wast" {
+				dirFiles = append(dirFiles, file)
+			}
+			return err
+		})
+		if err != nil {
+			return nil, err
+		}
+		sort.Strings(dirFiles)
+		files = append(files, dirFiles...)
+	}
+	return files, nil
+}
+
+func runTestFile(filename string, features binary.Features) (r fileReport) {
+	r.File = filename
+	start := time.Now()
+	defer func() {
+		r.Time = time.Since(start).Seconds()
+	}()
+
+	s, err := text.CompileScriptFile(filename)
+	if err != nil {
+		r.Error = err.Error()
+		return
+	}
+	t := newWastTester(s, filepath.Dir(filename), features)
+	t.keepGoing = true
+	_ = t.test()
+
+	r.results = t.results
+	for _, result := range t.results {
+		switch result.Err {
+		case nil:
+			r.Passed++
+		case errSkipped:
+			r.Skipped++
+		default:
+			r.Failed++
+			r.Failures = append(r.Failures, failureReport{
+				Cmd:     result.Cmd,
+				Message: result.Err.Error(),
+			})
+		}
+	}
+	return
+}
+
+func (r fileReport) ok() bool {
+	return r.Error == "" && r.Failed == 0
+}
+
+// returns the number of failed files
+func printReports(reports []fileReport, w io.Writer) (failedFiles int) {
+	passed, failed, skipped := 0, 0, 0
+	for _, r := range reports {
+		status := "ok  "
+		if !r.ok() {
+			status = "FAIL"
+			failedFiles++
+		}
+		fmt.Fprintf(w, "%s %s (%d passed, %d failed, %d skipped, %.2fs)\n",
+			status, r.File, r.Passed, r.Failed, r.Skipped, r.Time)
+		if r.Error != "" {
+			fmt.Fprintf(w, "    %s\n", indent(r.Error))
+		}
+		for _, f := range r.Failures {
+			fmt.Fprintf(w, "    %s: %s\n", f.Cmd, indent(f.Message))
+		}
+		passed += r.Passed
+		failed += r.Failed
+		skipped += r.Skipped
+	}
+	fmt.Fprintf(w, "%d files (%d failed), %d passed, %d failed, %d skipped\n",
+		len(reports), failedFiles, passed, failed, skipped)
+	return
+}
+
+func indent(s string) string {
+	return strings.ReplaceAll(s, "\n", "\n    ")
+}
+
+/* reports */
+
+func writeJSONReport(reports []fileReport, filename string) error {
+	data, err := json.MarshalIndent(reports, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filename, data, 0644)
+}
+
+type junitTestSuites struct {
+	XMLName  xml.Name         `xml:"testsuites"`
+	Tests    int              `xml:"tests,attr"`
+	Failures int              `xml:"failures,attr"`
+	Errors   int              `xml:"errors,attr"`
+	Skipped  int              `xml:"skipped,attr"`
+	Suites   []junitTestSuite `xml:"testsuite"`
+}
+
+type junitTestSuite struct {
+	Name     string          `xml:"name,attr"`
+	Tests    int             `xml:"tests,attr"`
+	Failures int             `xml:"failures,attr"`
+	Errors   int             `xml:"errors,attr"`
+	Skipped  int             `xml:"skipped,attr"`
+	Time     string          `xml:"time,attr"`
+	Cases    []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	Name      string        `xml:"name,attr"`
+	ClassName string        `xml:"classname,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Error     *junitMessage `xml:"error,omitempty"`
+	Skipped   *junitMessage `xml:"skipped,omitempty"`
+}
+
+type junitMessage struct {
+	Message string `xml:"message,attr,omitempty"`
+	Text    string `xml:",chardata"`
+}
+
+func writeJUnitReport(reports []fileReport, filename string) error {
+	suites := junitTestSuites{}
+	for _, r := range reports {
+		suite := junitTestSuite{
+			Name:     r.File,
+			Failures: r.Failed,
+			Skipped:  r.Skipped,
+			Time:     fmt.Sprintf("%.3f", r.Time),
+		}
+		if r.Error != "" {
+			suite.Errors = 1
+			suite.Cases = append(suite.Cases, junitTestCase{
+				Name:      "compile",
+				ClassName: r.File,
+				Error:     &junitMessage{Message: "compile error", Text: r.Error},
+			})
+		}
+		for _, result := range r.results {
+			c := junitTestCase{Name: result.Cmd, ClassName: r.File}
+			switch result.Err {
+			case nil:
+			case errSkipped:
+				c.Skipped = &junitMessage{}
+			default:
+				msg := result.Err.Error()
+				c.Failure = &junitMessage{Message: firstLine(msg), Text: msg}
+			}
+			suite.Cases = append(suite.Cases, c)
+		}
+		suite.Tests = len(suite.Cases)
+		suites.Tests += suite.Tests
+		suites.Failures += suite.Failures
+		suites.Errors += suite.Errors
+		suites.Skipped += suite.Skipped
+		suites.Suites = append(suites.Suites, suite)
+	}
+
+	data, err := xml.MarshalIndent(suites, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filename, append([]byte(xml.Header), data...), 0644)
+}
+
+func firstLine(s string) string {
+	if i := strings.IndexByte(s, '\n'); i >= 0 {
+		return s[:i]
+	}
+	return s
+}
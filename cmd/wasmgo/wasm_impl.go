@@ -12,28 +12,24 @@ var _ WasmImpl = (*WasmInterpreter)(nil)
 type WasmImpl interface {
 	Validate(m binary.Module) error
 	Instantiate(m binary.Module, instances instance.Map) (instance.Instance, error)
-	InstantiateBin(data []byte, instances instance.Map) (instance.Instance, error)
 }
 
 type WasmInterpreter struct {
+	Features binary.Features
 }
 
-func (WasmInterpreter) Validate(m binary.Module) error {
-	err, _ := validator.Validate(m)
+func (w WasmInterpreter) Validate(m binary.Module) error {
+	err, _ := validator.ValidateWithOptions(m,
+		validator.Options{Features: w.Features})
 	return err
 }
 
-func (WasmInterpreter) Instantiate(
+// the interpreter accepts all features it implements,
+// so the module is validated against the enabled ones first
+func (w WasmInterpreter) Instantiate(
 	m binary.Module, instances instance.Map) (instance.Instance, error) {
 
-	return interpreter.NewInstance(m, instances)
-}
-
-func (WasmInterpreter) InstantiateBin(
-	data []byte, instances instance.Map) (instance.Instance, error) {
-
-	m, err := binary.Decode(data)
-	if err != nil {
+	if err := w.Validate(m); err != nil {
 		return nil, err
 	}
 	return interpreter.NewInstance(m, instances)
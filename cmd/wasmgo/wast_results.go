@@ -0,0 +1,241 @@
+package main
+
+import (
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/zxh0/wasm.go/instance"
+	"github.com/zxh0/wasm.go/text"
+)
+
+// value of (ref.extern n)
+type externRef uint32
+
+// v128 values are [16]byte in little endian
+
+func getArgs(consts []text.Result) ([]interface{}, error) {
+	args := make([]interface{}, len(consts))
+	for i, c := range consts {
+		switch c.Kind {
+		case text.ResultConst:
+			args[i] = c.Value
+		case text.ResultRefNull:
+			args[i] = nil
+		case text.ResultRefExtern:
+			args[i] = externRef(c.Value.(uint32))
+		case text.ResultV128:
+			args[i] = getV128(c)
+		default:
+			return nil, fmt.Errorf("unsupported argument: %s", formatResult(c))
+		}
+	}
+	return args, nil
+}
+
+// n is the number of results in the function type,
+// multiple values are returned as []interface{}
+func getResults(result interface{}, n int) []interface{} {
+	switch n {
+	case 0:
+		return nil
+	case 1:
+		return []interface{}{result}
+	default:
+		results, _ := result.([]interface{})
+		return results
+	}
+}
+
+func assertReturn(expected []text.Result, results []interface{}, err error) error {
+	if err != nil {
+		return fmt.Errorf("expected return: %s, got: %v",
+			formatResults(expected), err)
+	}
+
+	if len(results) != len(expected) {
+		return fmt.Errorf("expected %d results: %s, got %d: %s",
+			len(expected), formatResults(expected),
+			len(results), formatValues(results))
+	}
+	for i, r := range expected {
+		if !matchResult(r, results[i]) {
+			return fmt.Errorf("result #%d: expected %s, got %s",
+				i, formatResult(r), formatValue(results[i]))
+		}
+	}
+	return nil
+}
+
+func matchResult(r text.Result, val interface{}) bool {
+	switch r.Kind {
+	case text.ResultConst:
+		return matchConst(r.Value, val)
+	case text.ResultCanonicalNaN, text.ResultArithmeticNaN:
+		return matchNaN(r.Kind, val)
+	case text.ResultRefNull:
+		return val == nil
+	case text.ResultRefFunc:
+		_, ok := val.(instance.Function)
+		return ok
+	case text.ResultRefExtern:
+		return val == externRef(r.Value.(uint32))
+	case text.ResultV128:
+		v, ok := val.([16]byte)
+		if !ok {
+			return false
+		}
+		for i, lane := range r.Lanes {
+			if !matchResult(lane, getLane(v, r.Type, i)) {
+				return false
+			}
+		}
+		return true
+	default:
+		panic("unreachable")
+	}
+}
+
+// floats are compared bitwise, so NaN payloads and signed zeros matter
+func matchConst(expected, val interface{}) bool {
+	switch x := expected.(type) {
+	case float32:
+		f, ok := val.(float32)
+		return ok && math.Float32bits(f) == math.Float32bits(x)
+	case float64:
+		f, ok := val.(float64)
+		return ok && math.Float64bits(f) == math.Float64bits(x)
+	default:
+		return val == expected
+	}
+}
+
+func matchNaN(kind byte, val interface{}) bool {
+	switch x := val.(type) {
+	case float32:
+		bits := math.Float32bits(x)
+		if kind == text.ResultCanonicalNaN {
+			return bits&0x7FFFFFFF == 0x7FC00000
+		}
+		return bits&0x7FC00000 == 0x7FC00000
+	case float64:
+		bits := math.Float64bits(x)
+		if kind == text.ResultCanonicalNaN {
+			return bits&0x7FFFFFFFFFFFFFFF == 0x7FF8000000000000
+		}
+		return bits&0x7FF8000000000000 == 0x7FF8000000000000
+	default:
+		return false
+	}
+}
+
+func getLane(v [16]byte, shape string, i int) interface{} {
+	switch shape {
+	case "i8x16":
+		return int32(int8(v[i]))
+	case "i16x8":
+		return int32(int16(readLE(v[i*2:], 2)))
+	case "i32x4":
+		return int32(readLE(v[i*4:], 4))
+	case "i64x2":
+		return int64(readLE(v[i*8:], 8))
+	case "f32x4":
+		return math.Float32frombits(uint32(readLE(v[i*4:], 4)))
+	case "f64x2":
+		return math.Float64frombits(readLE(v[i*8:], 8))
+	default:
+		panic(fmt.Errorf("invalid v128 shape: %s", shape))
+	}
+}
+
+func getV128(c text.Result) (v [16]byte) {
+	n := 16 / len(c.Lanes)
+	for i, lane := range c.Lanes {
+		var bits uint64
+		switch x := lane.Value.(type) {
+		case int32:
+			bits = uint64(uint32(x))
+		case int64:
+			bits = uint64(x)
+		case float32:
+			bits = uint64(math.Float32bits(x))
+		case float64:
+			bits = math.Float64bits(x)
+		}
+		writeLE(v[i*n:], n, bits)
+	}
+	return
+}
+
+func readLE(b []byte, n int) (x uint64) {
+	for i := n - 1; i >= 0; i-- {
+		x = x<<8 | uint64(b[i])
+	}
+	return
+}
+
+func writeLE(b []byte, n int, x uint64) {
+	for i := 0; i < n; i++ {
+		b[i] = byte(x >> (8 * i))
+	}
+}
+
+/* formatting */
+
+func formatResults(results []text.Result) string {
+	strs := make([]string, len(results))
+	for i, r := range results {
+		strs[i] = formatResult(r)
+	}
+	return "[" + strings.Join(strs, " ") + "]"
+}
+
+func formatResult(r text.Result) string {
+	switch r.Kind {
+	case text.ResultConst:
+		return formatValue(r.Value)
+	case text.ResultCanonicalNaN:
+		return r.Type + ":nan:canonical"
+	case text.ResultArithmeticNaN:
+		return r.Type + ":nan:arithmetic"
+	case text.ResultRefNull:
+		return "ref.null"
+	case text.ResultRefFunc:
+		return "ref.func"
+	case text.ResultRefExtern:
+		return fmt.Sprintf("ref.extern:%d", r.Value)
+	case text.ResultV128:
+		return fmt.Sprintf("v128:%s%s", r.Type, formatResults(r.Lanes))
+	default:
+		panic("unreachable")
+	}
+}
+
+func formatValues(vals []interface{}) string {
+	strs := make([]string, len(vals))
+	for i, val := range vals {
+		strs[i] = formatValue(val)
+	}
+	return "[" + strings.Join(strs, " ") + "]"
+}
+
+func formatValue(val interface{}) string {
+	switch x := val.(type) {
+	case int32:
+		return fmt.Sprintf("i32:%d", x)
+	case int64:
+		return fmt.Sprintf("i64:%d", x)
+	case float32:
+		return fmt.Sprintf("f32:%v(0x%08x)", x, math.Float32bits(x))
+	case float64:
+		return fmt.Sprintf("f64:%v(0x%016x)", x, math.Float64bits(x))
+	case externRef:
+		return fmt.Sprintf("ref.extern:%d", x)
+	case [16]byte:
+		return fmt.Sprintf("v128:% x", x[:])
+	case nil:
+		return "ref.null"
+	default:
+		return fmt.Sprintf("ref:%v", x)
+	}
+}
@@ -1,8 +1,11 @@
 package main
 
 import (
+	"errors"
 	"fmt"
-	"math"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/zxh0/wasm.go/binary"
@@ -10,141 +13,335 @@ import (
 	"github.com/zxh0/wasm.go/text"
 )
 
+var errSkipped = errors.New("skipped")
+
 type wastTester struct {
 	script    *text.Script
+	dir       string
+	keepGoing bool // run all commands instead of stopping at the first failure
+	results   []wastResult
+	features  binary.Features
 	wasmImpl  WasmImpl
 	instances map[string]instance.Instance
 	instance  instance.Instance
+	modules   map[string]binary.Module
+	module    *binary.Module
+	scripts   map[string]*text.Script
 }
 
-func newWastTester(script *text.Script) *wastTester {
+func newWastTester(script *text.Script, dir string,
+	features binary.Features) *wastTester {
+
 	return &wastTester{
 		script:   script,
-		wasmImpl: WasmInterpreter{},
+		dir:      dir,
+		features: features,
+		wasmImpl: WasmInterpreter{Features: features},
 		instances: map[string]instance.Instance{
 			"spectest": newSpecTestInstance(),
 		},
+		modules: map[string]binary.Module{},
+		scripts: map[string]*text.Script{},
 	}
 }
 
-func (t *wastTester) test() (err error) {
-	for _, cmd := range t.script.Cmds {
-		switch x := cmd.(type) {
-		case *text.Module:
-			err = t.instantiate(x)
-		case *text.BinaryModule:
-			t.instantiateBin(x.Data)
-		case *text.QuotedModule:
-			panic("TODO")
-		case *text.Register:
-			t.instances[x.ModuleName] = t.instance
-		case *text.Action:
-			_, _ = t.runAction(x) // TODO
-		case *text.Assertion:
-			err = t.runAssertion(x)
-		case text.Meta:
-			panic("TODO")
-		default:
-			panic("unreachable")
+type wastResult struct {
+	Cmd string
+	Err error // nil or errSkipped if the command did not fail
+}
+
+func (t *wastTester) test() error {
+	return t.run(t.script)
+}
+
+func (t *wastTester) run(script *text.Script) error {
+	for _, cmd := range script.Cmds {
+		err := t.runCmd(cmd)
+		t.results = append(t.results, wastResult{
+			Cmd: getCmdName(cmd, len(t.results)),
+			Err: err,
+		})
+		if err != nil && err != errSkipped && !t.keepGoing {
+			return err
 		}
-		if err != nil {
-			return
+	}
+	return nil
+}
+
+func (t *wastTester) runCmd(cmd interface{}) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+
+	switch x := cmd.(type) {
+	case *text.Module, *text.BinaryModule, *text.QuotedModule:
+		return t.instantiate(x)
+	case *text.Register:
+		t.instances[x.ModuleName] = t.instance
+		return nil
+	case *text.Action:
+		_, err = t.runAction(x)
+		return err
+	case *text.Assertion:
+		return t.runAssertion(x)
+	case *text.Meta:
+		return t.runMeta(x)
+	default:
+		panic("unreachable")
+	}
+}
+
+func getCmdName(cmd interface{}, idx int) string {
+	name := ""
+	switch x := cmd.(type) {
+	case *text.Module:
+		name = strings.TrimSpace("module " + x.Name)
+	case *text.BinaryModule:
+		name = strings.TrimSpace("module binary " + x.Name)
+	case *text.QuotedModule:
+		name = strings.TrimSpace("module quote " + x.Name)
+	case *text.Register:
+		name = fmt.Sprintf("register %q", x.ModuleName)
+	case *text.Action:
+		name = getActionName(x)
+	case *text.Assertion:
+		name = assertionNames[x.Kind]
+		if x.Action != nil {
+			name += " " + getActionName(x.Action)
 		}
+	case *text.Meta:
+		name = strings.TrimSpace(metaNames[x.Kind] + " " + x.Name)
 	}
-	return
+	return fmt.Sprintf("#%d %s", idx, name)
+}
+
+var assertionNames = [...]string{
+	text.AssertReturn:     "assert_return",
+	text.AssertTrap:       "assert_trap",
+	text.AssertExhaustion: "assert_exhaustion",
+	text.AssertMalformed:  "assert_malformed",
+	text.AssertInvalid:    "assert_invalid",
+	text.AssertUnlinkable: "assert_unlinkable",
 }
 
-func (t *wastTester) instantiate(m *text.Module) (err error) {
-	t.instance, err = t.wasmImpl.Instantiate(*m.Module, t.instances)
-	if err == nil && m.Name != "" {
-		t.instances[m.Name] = t.instance
+var metaNames = [...]string{
+	text.MetaScript: "script",
+	text.MetaInput:  "input",
+	text.MetaOutput: "output",
+}
+
+func getActionName(a *text.Action) string {
+	kind := "invoke"
+	if a.Kind == text.ActionGet {
+		kind = "get"
 	}
-	return err
+	if a.ModuleName != "" {
+		kind += " " + a.ModuleName
+	}
+	return fmt.Sprintf("%s %q", kind, a.ItemName)
 }
-func (t *wastTester) instantiateBin(data []byte) {
-	_, err := t.wasmImpl.InstantiateBin(data, t.instances)
+
+// m is *text.Module, *text.BinaryModule or *text.QuotedModule
+func (t *wastTester) instantiate(m interface{}) (err error) {
+	module, err := t.compileModule(m)
 	if err != nil {
-		panic(err)
+		return err
+	}
+	return t.instantiateModule(getModuleName(m), module)
+}
+func (t *wastTester) instantiateModule(name string, m binary.Module) (err error) {
+	t.module = &m
+	t.instance, err = t.wasmImpl.Instantiate(m, t.instances)
+	if err == nil && name != "" {
+		t.instances[name] = t.instance
+		t.modules[name] = m
+	}
+	return err
+}
+
+func getModuleName(m interface{}) string {
+	switch x := m.(type) {
+	case *text.Module:
+		return x.Name
+	case *text.BinaryModule:
+		return x.Name
+	case *text.QuotedModule:
+		return x.Name
+	default:
+		panic("unreachable")
+	}
+}
+
+// decodes or compiles m without instantiating it
+func (t *wastTester) compileModule(m interface{}) (binary.Module, error) {
+	switch x := m.(type) {
+	case *text.Module:
+		return *x.Module, nil
+	case *text.BinaryModule:
+		return binary.DecodeWithOptions(x.Data,
+			binary.DecodeOptions{Features: t.features})
+	case *text.QuotedModule:
+		module, err := x.Compile()
+		if err != nil {
+			return binary.Module{}, err
+		}
+		return *module, nil
+	default:
+		panic("unreachable")
+	}
+}
+
+func (t *wastTester) runMeta(m *text.Meta) error {
+	switch m.Kind {
+	case text.MetaScript:
+		if m.Name != "" {
+			t.scripts[m.Name] = m.Script
+		}
+		return t.run(m.Script)
+	case text.MetaInput:
+		return t.input(m.Name, m.FileName)
+	case text.MetaOutput:
+		return t.output(m.Name, m.FileName)
+	default:
+		panic("unreachable")
+	}
+}
+
+// .wast files are run as scripts, .wat and .wasm files are instantiated
+func (t *wastTester) input(name, filename string) error {
+	filename = t.path(filename)
+	switch filepath.Ext(filename) {
+	case ".wast":
+		s, err := text.CompileScriptFile(filename)
+		if err != nil {
+			return err
+		}
+		if name != "" {
+			t.scripts[name] = s
+		}
+		return t.run(s)
+	case ".wat":
+		m, err := text.CompileModuleFile(filename)
+		if err != nil {
+			return err
+		}
+		return t.instantiateModule(name, *m)
+	case ".wasm":
+		m, err := binary.DecodeFileWithOptions(filename,
+			binary.DecodeOptions{Features: t.features})
+		if err != nil {
+			return err
+		}
+		return t.instantiateModule(name, m)
+	default:
+		return fmt.Errorf("unknown input file type: %s", filename)
+	}
+}
+
+// writes the module as .wasm or .wat, or prints it if filename is empty
+func (t *wastTester) output(name, filename string) error {
+	m := t.module
+	if name != "" {
+		if _m, found := t.modules[name]; found {
+			m = &_m
+		} else {
+			return fmt.Errorf("unknown module: %s", name)
+		}
+	}
+	if m == nil {
+		return fmt.Errorf("no module to output")
+	}
+
+	if filename == "" {
+		return text.Print(*m, os.Stdout)
+	}
+	filename = t.path(filename)
+	switch filepath.Ext(filename) {
+	case ".wasm":
+		return ioutil.WriteFile(filename, binary.Encode(*m), 0644)
+	case ".wat":
+		f, err := os.Create(filename)
+		if err != nil {
+			return err
+		}
+		defer f.Close()
+		return text.Print(*m, f)
+	default:
+		return fmt.Errorf("unknown output file type: %s", filename)
 	}
-	// TODO: check
+}
+
+// files of meta commands are relative to the script
+func (t *wastTester) path(filename string) string {
+	if filepath.IsAbs(filename) {
+		return filename
+	}
+	return filepath.Join(t.dir, filename)
 }
 
 func (t *wastTester) runAssertion(a *text.Assertion) error {
 	switch a.Kind {
 	case text.AssertReturn:
-		result, err := t.runAction(a.Action)
-		return assertReturn(a.Result, result, err)
+		results, err := t.runAction(a.Action)
+		return assertReturn(a.Results, results, err)
 	case text.AssertTrap:
 		if a.Action != nil {
-			result, err := t.runAction(a.Action)
-			return assertTrap(a.Failure, result, err)
+			results, err := t.runAction(a.Action)
+			return assertTrap(a.Failure, results, err)
 		} else {
-			err := t.instantiate(a.Module.(*text.Module))
+			err := t.instantiate(a.Module)
 			return assertTrap(a.Failure, err, err)
 		}
 	case text.AssertExhaustion:
-		// panic("TODO")
+		return errSkipped // TODO
 	case text.AssertMalformed:
-		// panic("TODO")
+		_, err := t.compileModule(a.Module) // decodes or parses, doesn't validate
+		return assertMalformed(a.Failure, err)
 	case text.AssertInvalid:
-		m := *(a.Module.(*text.Module).Module)
-		err := t.wasmImpl.Validate(m)
+		m, err := t.compileModule(a.Module)
+		if err == nil {
+			err = t.wasmImpl.Validate(m)
+		}
 		return assertError(a.Failure, err)
 	case text.AssertUnlinkable:
-		err := t.instantiate(a.Module.(*text.Module))
+		err := t.instantiate(a.Module)
 		return assertError(a.Failure, err)
 	default:
 		panic("TODO")
 	}
-	return nil
 }
 
-func (t *wastTester) runAction(a *text.Action) (interface{}, error) {
+func (t *wastTester) runAction(a *text.Action) ([]interface{}, error) {
 	_i := t.instance
 	if a.ModuleName != "" {
 		_i = t.instances[a.ModuleName]
 	}
+	if _i == nil {
+		return nil, fmt.Errorf("unknown module: %q", a.ModuleName)
+	}
 
 	switch a.Kind {
 	case text.ActionInvoke:
-		//println("invoke " + a.ItemName)
-		return _i.CallFunc(a.ItemName, getConsts(a.Expr)...)
+		f, ok := _i.Get(a.ItemName).(instance.Function)
+		if !ok {
+			return nil, fmt.Errorf("unknown function: %q", a.ItemName)
+		}
+		args, err := getArgs(a.Args)
+		if err != nil {
+			return nil, err
+		}
+		result, err := _i.CallFunc(a.ItemName, args...)
+		return getResults(result, len(f.Type().ResultTypes)), err
 	case text.ActionGet:
-		//println("get " + a.ItemName)
-		return _i.GetGlobalValue(a.ItemName)
+		result, err := _i.GetGlobalValue(a.ItemName)
+		return getResults(result, 1), err
 	default:
 		panic("unreachable")
 	}
 }
 
-func assertReturn(expected []binary.Instruction,
-	result interface{}, err error) error {
-
-	if err != nil {
-		result = err
-	}
-
-	expectedVals := getConsts(expected)
-	var expectedVal interface{} = nil
-	if n := len(expectedVals); n == 1 {
-		expectedVal = expectedVals[0]
-	} else if n > 1 {
-		panic("TODO")
-	}
-
-	if isNaN32(expectedVal) { // TODO
-		if !isNaN32(result) {
-			return fmt.Errorf("expected return: NaN, got: %v", result)
-		}
-	} else if isNaN64(expectedVal) { // TODO
-		if !isNaN64(result) {
-			return fmt.Errorf("expected return: NaN, got: %v", result)
-		}
-	} else if result != expectedVal {
-		return fmt.Errorf("expected return: %v, got: %v", expectedVal, result)
-	}
-	return nil
-}
 func assertTrap(expectedErr string, result interface{}, err error) error {
 	if err == nil {
 		return fmt.Errorf("expected trap: %v, got: %v",
@@ -164,30 +361,74 @@ func assertError(expectedErr string, err error) error {
 	return nil
 }
 
-func getConsts(expr []binary.Instruction) []interface{} {
-	args := make([]interface{}, len(expr))
-	for i, instr := range expr {
-		switch instr.Opcode {
-		case binary.I32Const:
-			args[i] = instr.Args.(int32)
-		case binary.I64Const:
-			args[i] = instr.Args.(int64)
-		case binary.F32Const:
-			args[i] = instr.Args.(float32)
-		case binary.F64Const:
-			args[i] = instr.Args.(float64)
-		default:
-			panic("TODO")
-		}
-	}
-	return args
+// messages of the reference interpreter which differ from those
+// of the decoder and the parser, matched by prefix
+var malformedErrors = map[string][]string{
+	// binary
+	"magic header not detected": {"invalid magic number", "EOF"},
+	"unknown binary version":    {"unsupported version", "EOF"},
+	"unexpected end": {"EOF", "insufficient bytes", "LEB128 error", "out of bounds",
+		"invalid sec, id", "invalid code", "invalid expr end", "invalid block end"},
+	"length out of bounds":                  {"insufficient bytes", "out of bounds", "EOF"},
+	"integer representation too long":       {"LEB128 error"},
+	"integer too large":                     {"LEB128 error", "invalid limits flag", "expected 0, got"},
+	"malformed section id":                  {"invalid sec ID"},
+	"section size mismatch":                 {"invalid sec, id", "insufficient bytes", "invalid code"},
+	"junk after last section":               {"invalid sec ID"},
+	"unexpected content after last section": {"invalid sec ID"},
+	"zero byte expected":                    {"expected 0, got"},
+	"zero flag expected":                    {"expected 0, got"},
+	"illegal opcode":                        {"undefined opcode"},
+	"malformed reference type":              {"invalid valtype", "invalid heaptype", "invalid elemtype"},
+	"malformed value type":                  {"invalid valtype"},
+	"malformed import kind":                 {"invalid import desc tag"},
+	"malformed export kind":                 {"invalid export desc tag"},
+	"malformed mutability":                  {"invalid mut"},
+	"malformed limits flags":                {"invalid limits flag"},
+	"END opcode expected":                   {"invalid expr end", "invalid block end"},
+	// text
+	"unknown operator":  {"expected instruction", "unexpected token"},
+	"unexpected token":  {"unexpected token", "unexpected end of input"},
+	"unclosed string":   {"unclosed string", "unexpected end of input"},
+	"illegal escape":    {"illegal escape"},
+	"illegal character": {"illegal control character", "unexpected token"},
+	"alignment":         {"invalid align"},
+	"i32 constant":      {"constant out of range", "expected natural number", "unexpected token"},
+	"i64 constant":      {"constant out of range", "unexpected token"},
+	"unknown label":     {"undefined label variable"},
+	"unknown function":  {"undefined func variable"},
+	"unknown type":      {"undefined type variable"},
+	"unknown global":    {"undefined global variable"},
+	"unknown local":     {"undefined local variable"},
+	"duplicate":         {"redefinition of"},
+	"import after":      {"imports must occur before all non-import definitions"},
 }
 
-func isNaN32(x interface{}) bool {
-	f, ok := x.(float32)
-	return ok && math.IsNaN(float64(f))
+// the module must fail to decode or to parse, with the expected error
+func assertMalformed(expectedErr string, err error) error {
+	if err == nil {
+		return fmt.Errorf("expected malformed: %v, got: nil", expectedErr)
+	}
+	if !isMalformedErr(expectedErr, err) {
+		return fmt.Errorf("expected malformed: %v, got: %v", expectedErr, err)
+	}
+	return nil
 }
-func isNaN64(x interface{}) bool {
-	f, ok := x.(float64)
-	return ok && math.IsNaN(f)
+
+func isMalformedErr(expectedErr string, err error) bool {
+	msg := err.Error()
+	if strings.Contains(msg, expectedErr) {
+		return true
+	}
+	for prefix, msgs := range malformedErrors {
+		if !strings.HasPrefix(expectedErr, prefix) {
+			continue
+		}
+		for _, s := range msgs {
+			if strings.Contains(msg, s) {
+				return true
+			}
+		}
+	}
+	return false
 }
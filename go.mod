@@ -1,9 +1,8 @@
 module github.com/zxh0/wasm.go
 
-go 1.13
+go 1.14
 
 require (
-	github.com/antlr/antlr4 v0.0.0-20200214162551-db1c09c6d286
 	github.com/cpuguy83/go-md2man/v2 v2.0.0 // indirect
 	github.com/davecgh/go-spew v1.1.1 // indirect
 	github.com/stretchr/testify v1.4.0
@@ -30,10 +30,22 @@ type Global interface {
 	Set(val uint64)
 }
 
+// RefGlobal is a Global of a reference type. Its value is a Function,
+// an extern value or nil, instead of bits which are only meaningful
+// inside one instance.
+type RefGlobal interface {
+	Global
+	GetRef() interface{}
+	SetRef(ref interface{})
+}
+
+// Memory sizes are in pages and grow returns the old size, or
+// math.MaxUint64 on failure. 32-bit memories never exceed 2^16 pages,
+// so callers can truncate to uint32.
 type Memory interface {
 	Type() binary.MemType
-	Size() uint32 // page count
-	Grow(n uint32) uint32
+	Size() uint64 // page count
+	Grow(n uint64) uint64
 	Read(offset uint64, buf []byte)
 	Write(offset uint64, buf []byte)
 }
@@ -11,9 +11,9 @@ type nativeFunction struct {
 	f GoFunc
 }
 
-func (nf nativeFunction) Type() binary.FuncType {
+func (nf *nativeFunction) Type() binary.FuncType {
 	return nf.t
 }
-func (nf nativeFunction) Call(args ...interface{}) (interface{}, error) {
+func (nf *nativeFunction) Call(args ...interface{}) (interface{}, error) {
 	return nf.f(args...)
 }
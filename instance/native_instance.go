@@ -28,7 +28,15 @@ func (n *NativeInstance) RegisterFunc(name string,
 		}
 	}
 
-	n.exported[name] = nativeFunction{t: ft, f: f}
+	n.exported[name] = &nativeFunction{t: ft, f: f}
+}
+
+// registers a function of any type, multiple results
+// are returned as []interface{}
+func (n *NativeInstance) RegisterFuncOfType(name string,
+	f GoFunc, ft binary.FuncType) {
+
+	n.exported[name] = &nativeFunction{t: ft, f: f}
 }
 
 func (n *NativeInstance) Register(name string, x interface{}) {
@@ -0,0 +1,479 @@
+package interpreter
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/zxh0/wasm.go/binary"
+	"github.com/zxh0/wasm.go/instance"
+)
+
+// reasons of stops
+const (
+	StopBreakpoint = iota // before the instruction of a breakpoint
+	StopStep              // before the next instruction of a step
+	StopExit              // the called function returned or trapped
+)
+
+// stepping modes
+const (
+	runContinue = iota
+	runStepIn
+	runStepOver
+	runStepOut
+)
+
+var (
+	errNotRunning = errors.New("no function is running")
+	errRunning    = errors.New("a function is running")
+	errKilled     = trapError("killed")
+)
+
+// Debugger calls the exported functions of a module under control:
+// the execution stops at breakpoints and after steps, then the state of
+// the instance can be inspected until it is resumed.
+// The called function runs in its own goroutine, the methods of Debugger
+// must not be called concurrently.
+type Debugger struct {
+	vm          *vm
+	names       *binary.NameSec // nil if the module has no valid name section
+	breakpoints map[int]Breakpoint
+	nextID      int
+	running     bool
+	killed      bool
+	mode        int // stepping mode
+	depth       int // call depth where the step began
+	resume      chan struct{}
+	stops       chan *Stop
+}
+
+// Breakpoint stops the execution before an instruction of a function.
+type Breakpoint struct {
+	ID      int
+	FuncIdx int
+	Offset  int // offset of the instruction in the code like Trap.Location, -1 for the first one
+}
+
+// Stop describes why the execution stopped.
+type Stop struct {
+	Reason     int
+	Breakpoint int         // ID of the breakpoint, StopBreakpoint only
+	Result     interface{} // StopExit only
+	Err        error       // StopExit only, the trap if the function trapped
+}
+
+// Frame is a function on the call stack.
+type Frame struct {
+	FuncIdx  int
+	Name     string // see FuncName
+	Instr    binary.Instruction
+	Location string // location of Instr, e.g. func[3]+0x2a
+}
+
+// Value is a value of a local or global variable.
+type Value struct {
+	Type binary.ValType
+	Bits uint64
+}
+
+// Variable is a named value, the name is "" if it is unknown.
+type Variable struct {
+	Name  string
+	Value Value
+}
+
+// NewDebugger instantiates the module like NewInstance,
+// the start function runs without stopping.
+func NewDebugger(m binary.Module, instances instance.Map) (*Debugger, error) {
+	vm, err := newVM(m, instances)
+	if err != nil {
+		return nil, err
+	}
+	if err := vm.execStartFunc(); err != nil {
+		return nil, err
+	}
+
+	d := &Debugger{
+		vm:          vm,
+		breakpoints: map[int]Breakpoint{},
+		nextID:      1,
+		resume:      make(chan struct{}),
+		stops:       make(chan *Stop),
+	}
+	d.names, _ = m.GetNameSec()
+	vm.debugger = d
+	return d, nil
+}
+
+/* breakpoints */
+
+// BreakFunc adds a breakpoint before the first instruction of a function.
+func (d *Debugger) BreakFunc(fIdx int) (Breakpoint, error) {
+	if err := d.checkFunc(fIdx); err != nil {
+		return Breakpoint{}, err
+	}
+	return d.addBreakpoint(fIdx, -1), nil
+}
+
+// BreakAt adds a breakpoint at the offset of an instruction in the code
+// of a function, like the offsets in Trap.Location.
+func (d *Debugger) BreakAt(fIdx int, offset int) (Breakpoint, error) {
+	if err := d.checkFunc(fIdx); err != nil {
+		return Breakpoint{}, err
+	}
+	code := d.vm.funcs[fIdx].code
+	if code.Offset > 0 && findInstr(code.Expr, code.Offset+uint32(offset)) {
+		return d.addBreakpoint(fIdx, offset), nil
+	}
+	return Breakpoint{}, fmt.Errorf("no instruction at func[%d]+0x%x", fIdx, offset)
+}
+
+// only the code of defined functions has breakpoints
+func (d *Debugger) checkFunc(fIdx int) error {
+	if fIdx < 0 || fIdx >= len(d.vm.funcs) {
+		return fmt.Errorf("function index out of range: %d", fIdx)
+	}
+	if d.vm.funcs[fIdx].imported != nil {
+		return fmt.Errorf("function %d is imported", fIdx)
+	}
+	return nil
+}
+
+func (d *Debugger) addBreakpoint(fIdx, offset int) Breakpoint {
+	bp := Breakpoint{ID: d.nextID, FuncIdx: fIdx, Offset: offset}
+	d.breakpoints[bp.ID] = bp
+	d.nextID++
+	return bp
+}
+
+// reports whether an instruction of expr is at the offset in module
+func findInstr(expr binary.Expr, offset uint32) bool {
+	for _, instr := range expr {
+		if instr.Offset == offset {
+			return true
+		}
+		switch args := instr.Args.(type) {
+		case binary.BlockArgs:
+			if findInstr(args.Instrs, offset) {
+				return true
+			}
+		case binary.IfArgs:
+			if findInstr(args.Instrs1, offset) || findInstr(args.Instrs2, offset) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Delete removes a breakpoint.
+func (d *Debugger) Delete(id int) error {
+	if _, ok := d.breakpoints[id]; !ok {
+		return fmt.Errorf("no breakpoint %d", id)
+	}
+	delete(d.breakpoints, id)
+	return nil
+}
+
+// Breakpoints returns the breakpoints ordered by ID.
+func (d *Debugger) Breakpoints() []Breakpoint {
+	bps := make([]Breakpoint, 0, len(d.breakpoints))
+	for _, bp := range d.breakpoints {
+		bps = append(bps, bp)
+	}
+	sort.Slice(bps, func(i, j int) bool { return bps[i].ID < bps[j].ID })
+	return bps
+}
+
+/* execution */
+
+// Running reports whether a called function is stopped.
+func (d *Debugger) Running() bool {
+	return d.running
+}
+
+// Call calls an exported function and runs it until it stops.
+func (d *Debugger) Call(name string, args ...interface{}) (*Stop, error) {
+	if d.running {
+		return nil, errRunning
+	}
+	fIdx, ok := d.vm.getFunc(name)
+	if !ok {
+		return nil, fmt.Errorf("function not found: " + name)
+	}
+
+	d.running, d.killed = true, false
+	d.mode = runContinue
+	go func() {
+		result, err := d.vm.safeCallFunc(d.vm.funcs[fIdx], args)
+		d.stops <- &Stop{Reason: StopExit, Result: result, Err: err}
+	}()
+	return d.wait(), nil
+}
+
+// Continue runs until a breakpoint or the end of the called function.
+func (d *Debugger) Continue() (*Stop, error) {
+	return d.run(runContinue)
+}
+
+// StepIn runs until the next instruction, which may be in a called function.
+func (d *Debugger) StepIn() (*Stop, error) {
+	return d.run(runStepIn)
+}
+
+// StepOver runs until the next instruction of the current function
+// or its callers.
+func (d *Debugger) StepOver() (*Stop, error) {
+	return d.run(runStepOver)
+}
+
+// StepOut runs until the current function returns to its caller.
+func (d *Debugger) StepOut() (*Stop, error) {
+	return d.run(runStepOut)
+}
+
+// Kill aborts the called function, which exits with a trap.
+func (d *Debugger) Kill() (*Stop, error) {
+	if !d.running {
+		return nil, errNotRunning
+	}
+	d.killed = true
+	d.resume <- struct{}{}
+	return d.wait(), nil
+}
+
+func (d *Debugger) run(mode int) (*Stop, error) {
+	if !d.running {
+		return nil, errNotRunning
+	}
+	d.mode = mode
+	d.depth = d.getCallDepth()
+	d.resume <- struct{}{}
+	return d.wait(), nil
+}
+
+func (d *Debugger) wait() *Stop {
+	stop := <-d.stops
+	if stop.Reason == StopExit {
+		d.running = false
+	}
+	return stop
+}
+
+// called by the vm before executing instr, blocks while stopped
+func (d *Debugger) beforeInstr(instr binary.Instruction) {
+	stop := d.checkStop(instr)
+	if stop == nil {
+		return
+	}
+	d.stops <- stop
+	<-d.resume
+	if d.killed {
+		d.vm.topBlockFrame().pc++ // trap at instr
+		panic(errKilled)
+	}
+}
+
+func (d *Debugger) checkStop(instr binary.Instruction) *Stop {
+	ff := d.vm.topFuncFrame()
+	code := d.vm.funcs[ff.fIdx].code
+	entry := d.vm.topBlockFrame() == ff && ff.pc == 0
+	for _, bp := range d.breakpoints {
+		if bp.FuncIdx == ff.fIdx && (bp.Offset < 0 && entry ||
+			bp.Offset >= 0 && code.Offset > 0 && instr.Offset == code.Offset+uint32(bp.Offset)) {
+
+			return &Stop{Reason: StopBreakpoint, Breakpoint: bp.ID}
+		}
+	}
+
+	switch d.mode {
+	case runStepIn:
+		return &Stop{Reason: StopStep}
+	case runStepOver:
+		if d.getCallDepth() <= d.depth {
+			return &Stop{Reason: StopStep}
+		}
+	case runStepOut:
+		if d.getCallDepth() < d.depth {
+			return &Stop{Reason: StopStep}
+		}
+	}
+	return nil
+}
+
+// the number of functions on the call stack
+func (d *Debugger) getCallDepth() int {
+	n := 0
+	for _, bf := range d.vm.frames {
+		if bf.bt == btFunc {
+			n++
+		}
+	}
+	return n
+}
+
+/* inspection */
+
+// FuncName returns the name of a function from the name section,
+// or its export name, or "".
+func (d *Debugger) FuncName(fIdx int) string {
+	if d.names != nil {
+		if name, ok := d.names.FuncNames[uint32(fIdx)]; ok {
+			return name
+		}
+	}
+	for _, exp := range d.vm.module.ExportSec {
+		if exp.Desc.Tag == binary.ExportTagFunc && int(exp.Desc.Idx) == fIdx {
+			return exp.Name
+		}
+	}
+	return ""
+}
+
+// LookupFunc returns the index of a function by its name
+// (see FuncName) or index.
+func (d *Debugger) LookupFunc(name string) (int, bool) {
+	for i := range d.vm.funcs {
+		if d.FuncName(i) == name {
+			return i, true
+		}
+	}
+	if idx, err := strconv.Atoi(name); err == nil && idx >= 0 && idx < len(d.vm.funcs) {
+		return idx, true
+	}
+	return 0, false
+}
+
+// Frames returns the call stack of the stopped function, innermost first.
+// The instruction of the innermost frame is the next one to execute,
+// those of the other frames are the calls.
+func (d *Debugger) Frames() []Frame {
+	if !d.running {
+		return nil
+	}
+	var frames []Frame
+	var instr *binary.Instruction
+	for i := len(d.vm.frames) - 1; i >= 0; i-- {
+		bf := d.vm.frames[i]
+		if instr == nil {
+			if i == len(d.vm.frames)-1 {
+				instr = &bf.instrs[bf.pc]
+			} else if bf.pc > 0 {
+				instr = &bf.instrs[bf.pc-1]
+			}
+		}
+		if bf.bt == btFunc {
+			frame := Frame{FuncIdx: bf.fIdx, Name: d.FuncName(bf.fIdx)}
+			if instr != nil {
+				frame.Instr = *instr
+				frame.Location = d.vm.funcs[bf.fIdx].code.Location(bf.fIdx, *instr)
+			}
+			frames = append(frames, frame)
+			instr = nil
+		}
+	}
+	return frames
+}
+
+// Locals returns the params and locals of the nth frame of Frames.
+func (d *Debugger) Locals(n int) ([]Variable, error) {
+	bf := d.getFuncFrame(n)
+	if bf == nil {
+		return nil, fmt.Errorf("no frame %d", n)
+	}
+	f := d.vm.funcs[bf.fIdx]
+	types := append([]binary.ValType{}, f._type.ParamTypes...)
+	for _, locals := range f.code.Locals {
+		for i := uint32(0); i < locals.N; i++ {
+			types = append(types, locals.Type)
+		}
+	}
+
+	vars := make([]Variable, len(types))
+	for i, vt := range types {
+		vars[i].Value = Value{Type: vt, Bits: d.vm.operandStack.data[bf.bp+i]}
+		if d.names != nil {
+			vars[i].Name = d.names.LocalNames[uint32(bf.fIdx)][uint32(i)]
+		}
+	}
+	return vars, nil
+}
+
+// the nth function frame from the top
+func (d *Debugger) getFuncFrame(n int) *blockFrame {
+	if !d.running {
+		return nil
+	}
+	for i := len(d.vm.frames) - 1; i >= 0; i-- {
+		if bf := d.vm.frames[i]; bf.bt == btFunc {
+			if n == 0 {
+				return bf
+			}
+			n--
+		}
+	}
+	return nil
+}
+
+// Stack returns the operands of the innermost function, the top is last.
+// Operands are untyped on the stack.
+func (d *Debugger) Stack() []uint64 {
+	bf := d.getFuncFrame(0)
+	if bf == nil {
+		return nil
+	}
+	f := d.vm.funcs[bf.fIdx]
+	start := bf.bp + len(f._type.ParamTypes) + f.code.GetLocalCount()
+	return append([]uint64{}, d.vm.operandStack.data[start:]...)
+}
+
+// Globals returns the globals, named by their exports.
+func (d *Debugger) Globals() []Variable {
+	vars := make([]Variable, len(d.vm.globals))
+	for i, g := range d.vm.globals {
+		vars[i].Value = Value{Type: g.Type().ValType, Bits: g.Get()}
+	}
+	for _, exp := range d.vm.module.ExportSec {
+		if exp.Desc.Tag == binary.ExportTagGlobal {
+			vars[exp.Desc.Idx].Name = exp.Name
+		}
+	}
+	return vars
+}
+
+// ReadMemory reads n bytes of the memory at the offset.
+func (d *Debugger) ReadMemory(offset uint64, n int) ([]byte, error) {
+	mem := d.vm.memory
+	if mem == nil {
+		return nil, fmt.Errorf("no memory")
+	}
+	size := mem.Size() * binary.PageSize
+	if offset > size || uint64(n) > size-offset {
+		return nil, fmt.Errorf("out of bounds memory access: 0x%x+%d (size 0x%x)",
+			offset, n, size)
+	}
+	buf := make([]byte, n)
+	mem.Read(offset, buf)
+	return buf, nil
+}
+
+func (v Value) String() string {
+	switch v.Type {
+	case binary.ValTypeI32:
+		return strconv.Itoa(int(int32(v.Bits)))
+	case binary.ValTypeI64:
+		return strconv.FormatInt(int64(v.Bits), 10)
+	case binary.ValTypeF32:
+		return strconv.FormatFloat(float64(math.Float32frombits(uint32(v.Bits))), 'g', -1, 32)
+	case binary.ValTypeF64:
+		return strconv.FormatFloat(math.Float64frombits(v.Bits), 'g', -1, 64)
+	default:
+		if v.Bits == 0 {
+			return "null"
+		}
+		return fmt.Sprintf("ref#%d", v.Bits)
+	}
+}
@@ -0,0 +1,160 @@
+package interpreter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zxh0/wasm.go/binary"
+	"github.com/zxh0/wasm.go/text"
+)
+
+const debuggerTestWAT = `(module
+  (global $g (export "g") (mut i32) (i32.const 7))
+  (memory 1)
+  (data (i32.const 0) "hi")
+  (func $add (param $a i32) (param $b i32) (result i32)
+    local.get $a
+    local.get $b
+    i32.add)
+  (func $main (export "main") (param $x i32) (result i32) (local $y i32)
+    i32.const 1
+    local.set $y
+    local.get $x
+    local.get $y
+    call $add
+    i32.const 2
+    i32.add)
+  (func (export "trap")
+    unreachable))`
+
+func newTestDebugger(t *testing.T) (*Debugger, binary.Module) {
+	m, err := text.CompileModuleStrWithOptions(debuggerTestWAT,
+		text.CompileOptions{Names: true})
+	require.NoError(t, err)
+	decoded, err := binary.Decode(binary.Encode(*m)) // with offsets
+	require.NoError(t, err)
+	d, err := NewDebugger(decoded, nil)
+	require.NoError(t, err)
+	return d, decoded
+}
+
+func TestDebuggerBreakpoints(t *testing.T) {
+	d, _ := newTestDebugger(t)
+	idx, ok := d.LookupFunc("add")
+	require.True(t, ok)
+	bp, err := d.BreakFunc(idx)
+	require.NoError(t, err)
+
+	stop, err := d.Call("main", int32(5))
+	require.NoError(t, err)
+	require.Equal(t, &Stop{Reason: StopBreakpoint, Breakpoint: bp.ID}, stop)
+	require.True(t, d.Running())
+
+	frames := d.Frames()
+	require.Len(t, frames, 2)
+	require.Equal(t, "add", frames[0].Name)
+	require.Equal(t, byte(binary.LocalGet), frames[0].Instr.Opcode)
+	require.Equal(t, "main", frames[1].Name)
+	require.Equal(t, byte(binary.Call), frames[1].Instr.Opcode)
+	require.Regexp(t, `^func\[1\]\+0x`, frames[1].Location)
+
+	locals, err := d.Locals(0)
+	require.NoError(t, err)
+	require.Equal(t, []Variable{
+		{Name: "a", Value: Value{Type: binary.ValTypeI32, Bits: 5}},
+		{Name: "b", Value: Value{Type: binary.ValTypeI32, Bits: 1}},
+	}, locals)
+	locals, err = d.Locals(1)
+	require.NoError(t, err)
+	require.Equal(t, "y", locals[1].Name)
+	require.Equal(t, "1", locals[1].Value.String())
+	_, err = d.Locals(2)
+	require.Error(t, err)
+	require.Empty(t, d.Stack())
+
+	require.Equal(t, []Variable{
+		{Name: "g", Value: Value{Type: binary.ValTypeI32, Bits: 7}},
+	}, d.Globals())
+	mem, err := d.ReadMemory(0, 2)
+	require.NoError(t, err)
+	require.Equal(t, []byte("hi"), mem)
+	_, err = d.ReadMemory(binary.PageSize-1, 2)
+	require.Error(t, err)
+
+	stop, err = d.Continue()
+	require.NoError(t, err)
+	require.Equal(t, &Stop{Reason: StopExit, Result: int32(8)}, stop)
+	require.False(t, d.Running())
+	require.Nil(t, d.Frames())
+	_, err = d.Continue()
+	require.Error(t, err)
+
+	require.NoError(t, d.Delete(bp.ID))
+	require.Error(t, d.Delete(bp.ID))
+	require.Empty(t, d.Breakpoints())
+	_, err = d.BreakFunc(3)
+	require.Error(t, err)
+}
+
+func TestDebuggerBreakAt(t *testing.T) {
+	d, m := newTestDebugger(t)
+	code := m.CodeSec[1]
+	offset := int(code.Expr[4].Offset - code.Offset) // call $add
+	bp, err := d.BreakAt(1, offset)
+	require.NoError(t, err)
+	require.Equal(t, []Breakpoint{{ID: 1, FuncIdx: 1, Offset: offset}}, d.Breakpoints())
+	_, err = d.BreakAt(1, offset+1)
+	require.Error(t, err)
+
+	stop, err := d.Call("main", int32(5))
+	require.NoError(t, err)
+	require.Equal(t, bp.ID, stop.Breakpoint)
+	require.Equal(t, []uint64{5, 1}, d.Stack())
+	require.Equal(t, code.Location(1, code.Expr[4]), d.Frames()[0].Location)
+}
+
+func TestDebuggerSteps(t *testing.T) {
+	d, _ := newTestDebugger(t)
+	_, err := d.BreakFunc(1)
+	require.NoError(t, err)
+	_, err = d.Call("main", int32(5))
+	require.NoError(t, err)
+
+	step := func(f func() (*Stop, error), fIdx int, opcode byte) {
+		stop, err := f()
+		require.NoError(t, err)
+		require.Equal(t, StopStep, stop.Reason)
+		frame := d.Frames()[0]
+		require.Equal(t, fIdx, frame.FuncIdx)
+		require.Equal(t, opcode, frame.Instr.Opcode)
+	}
+	step(d.StepOver, 1, binary.LocalSet)
+	step(d.StepOver, 1, binary.LocalGet)
+	step(d.StepOver, 1, binary.LocalGet)
+	step(d.StepOver, 1, binary.Call)
+	step(d.StepIn, 0, binary.LocalGet)
+	step(d.StepIn, 0, binary.LocalGet)
+	step(d.StepOut, 1, binary.I32Const)
+	require.Equal(t, []uint64{6}, d.Stack())
+
+	_, err = d.Call("main", int32(1))
+	require.Error(t, err)
+	stop, err := d.Kill()
+	require.NoError(t, err)
+	require.Equal(t, StopExit, stop.Reason)
+	require.EqualError(t, stop.Err, "func[1]+0xd: killed")
+
+	stop, err = d.Call("main", int32(1))
+	require.NoError(t, err)
+	step(d.StepOver, 1, binary.LocalSet)
+	for stop.Reason != StopExit {
+		stop, err = d.StepOver()
+		require.NoError(t, err)
+	}
+	require.Equal(t, int32(4), stop.Result)
+
+	stop, err = d.Call("trap")
+	require.NoError(t, err)
+	require.Equal(t, StopExit, stop.Reason)
+	require.Error(t, stop.Err)
+}
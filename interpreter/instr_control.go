@@ -1,11 +1,14 @@
 package interpreter
 
 import (
+	"fmt"
+
 	"github.com/zxh0/wasm.go/binary"
+	"github.com/zxh0/wasm.go/instance"
 )
 
 func unreachable(vm *vm, _ interface{}) {
-	panic("unreachable") // TODO
+	panic(errUnreachable)
 }
 
 func nop(vm *vm, _ interface{}) {
@@ -87,8 +90,11 @@ func callFunc(vm *vm, f vmFunc) {
 func callExternalFunc(vm *vm, f vmFunc) {
 	args := popArgs(vm, f._type)
 	result, err := f.imported.Call(args...)
+	if vm.listener != nil {
+		vm.listener.HostCall(f.idx, args, result, err)
+	}
 	if err != nil {
-		panic(err)
+		trapHostError(err)
 	}
 	pushResult(vm, f._type, result)
 }
@@ -106,23 +112,41 @@ func popArgs(vm *vm, sig binary.FuncType) []interface{} {
 			args[i] = vm.popF32()
 		case binary.ValTypeF64:
 			args[i] = vm.popF64()
+		default:
+			args[i] = vm.popRef()
 		}
 	}
 	return args
 }
 
 func pushResult(vm *vm, sig binary.FuncType, result interface{}) {
-	if len(sig.ResultTypes) > 0 {
-		switch sig.ResultTypes[0] {
-		case binary.ValTypeI32:
-			vm.pushS32(result.(int32))
-		case binary.ValTypeI64:
-			vm.pushS64(result.(int64))
-		case binary.ValTypeF32:
-			vm.pushF32(result.(float32))
-		case binary.ValTypeF64:
-			vm.pushF64(result.(float64))
+	switch n := len(sig.ResultTypes); n {
+	case 0:
+	case 1:
+		pushValue(vm, sig.ResultTypes[0], result)
+	default:
+		results, ok := result.([]interface{})
+		if !ok || len(results) != n {
+			panic(trapError(fmt.Sprintf("result count: %d, got: %v", n, result)))
 		}
+		for i, vt := range sig.ResultTypes {
+			pushValue(vm, vt, results[i])
+		}
+	}
+}
+
+func pushValue(vm *vm, vt binary.ValType, val interface{}) {
+	switch vt {
+	case binary.ValTypeI32:
+		vm.pushS32(val.(int32))
+	case binary.ValTypeI64:
+		vm.pushS64(val.(int64))
+	case binary.ValTypeF32:
+		vm.pushF32(val.(float32))
+	case binary.ValTypeF64:
+		vm.pushF64(val.(float64))
+	default:
+		vm.pushRef(val)
 	}
 }
 
@@ -141,6 +165,10 @@ operand stack:
 |  ............ |
 */
 func callInternalFunc(vm *vm, f vmFunc) {
+	if vm.listener != nil {
+		vm.listener.EnterFunc(f.idx, vm.peekValues(f._type.ParamTypes))
+	}
+
 	// alloc locals
 	localCount := f.code.GetLocalCount()
 	for i := 0; i < localCount; i++ {
@@ -149,6 +177,7 @@ func callInternalFunc(vm *vm, f vmFunc) {
 
 	paramsCount := len(f._type.ParamTypes)
 	vm.enterBlock(f.code.Expr, f._type.ResultTypes, btFunc, localCount+paramsCount)
+	vm.topBlockFrame().fIdx = f.idx
 }
 
 func callIndirect(vm *vm, args interface{}) {
@@ -157,14 +186,61 @@ func callIndirect(vm *vm, args interface{}) {
 
 	i := vm.popU32()
 	if i >= vm.table.Size() {
-		panic("undefined element") // TODO
+		panic(errUndefinedElem)
 	}
 
 	f := vm.table.GetElem(i)
 	if f.Type().GetSignature() != ft.GetSignature() {
-		panic("indirect call type mismatch") // TODO
+		panic(errIndirectCallType)
+	}
+	callFuncRef(vm, f, ft)
+}
+
+func callRef(vm *vm, args interface{}) {
+	ft := vm.module.TypeSec[args.(uint32)]
+	f := vm.popFuncRef()
+	if f == nil {
+		panic(errNullFuncRef)
+	}
+	callFuncRef(vm, f, ft)
+}
+
+// tail call: drop the frame of current func, then call f
+func returnCallRef(vm *vm, args interface{}) {
+	ft := vm.module.TypeSec[args.(uint32)]
+	f := vm.popFuncRef()
+	if f == nil {
+		panic(errNullFuncRef)
+	}
+
+	n := len(ft.ParamTypes)
+	params := make([]uint64, n)
+	for i := n - 1; i >= 0; i-- {
+		params[i] = vm.popU64()
+	}
+	var bf *blockFrame
+	for {
+		bf = vm.popBlockFrame()
+		if bf.bt == btFunc {
+			break
+		}
+	}
+	for vm.stackSize() > bf.bp {
+		vm.popU64()
 	}
+	if vm.blockDepth() > 0 {
+		vm.local0Idx = uint32(vm.topFuncFrame().bp)
+	}
+	if vm.listener != nil {
+		vm.listener.ExitFunc(bf.fIdx, nil)
+	}
+	for _, param := range params {
+		vm.pushU64(param)
+	}
+	callFuncRef(vm, f, ft)
+}
 
+func callFuncRef(vm *vm, f instance.Function, ft binary.FuncType) {
 	// optimize internal func call
 	if _f, ok := f.(vmFunc); ok {
 		if _f.imported == nil && _f.vm == vm {
@@ -176,7 +252,7 @@ func callIndirect(vm *vm, args interface{}) {
 	fcArgs := popArgs(vm, ft)
 	result, err := f.Call(fcArgs...)
 	if err != nil {
-		panic(err)
+		trapHostError(err)
 	}
 	pushResult(vm, ft, result)
 }
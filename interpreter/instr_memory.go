@@ -9,11 +9,15 @@ import (
 var byteOrder = gobin.LittleEndian
 
 func memorySize(vm *vm, _ interface{}) {
-	vm.pushU32(vm.memory.Size())
+	vm.pushAddr(vm.memory.Size())
 }
 func memoryGrow(vm *vm, _ interface{}) {
-	n := vm.memory.Grow(vm.popU32())
-	vm.pushU32(n)
+	delta := vm.popAddr()
+	n := vm.memory.Grow(delta)
+	if vm.listener != nil {
+		vm.listener.MemoryGrow(delta, n)
+	}
+	vm.pushAddr(n)
 }
 
 // load
@@ -164,5 +168,24 @@ func writeU64(vm *vm, memArg interface{}, n uint64) {
 
 func getOffset(vm *vm, memArg interface{}) uint64 {
 	offset := memArg.(binary.MemArg).Offset
-	return uint64(vm.popU32()) + uint64(offset)
+	addr := vm.popAddr()
+	if addr+offset < addr { // overflow
+		panic(errOutOfBoundsMemory)
+	}
+	return addr + offset
+}
+
+// addresses are i32 for 32-bit memories, i64 for 64-bit memories
+func (vm *vm) pushAddr(addr uint64) {
+	if vm.memory.Type().Is64() {
+		vm.pushU64(addr)
+	} else {
+		vm.pushU32(uint32(addr))
+	}
+}
+func (vm *vm) popAddr() uint64 {
+	if vm.memory.Type().Is64() {
+		return vm.popU64()
+	}
+	return uint64(vm.popU32())
 }
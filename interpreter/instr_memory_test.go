@@ -38,10 +38,42 @@ func TestMemOps(t *testing.T) {
 	testMemOp(t, vm, binary.I64Store32, binary.I64Load32U, 0xE0, 0x0E, int32(1000000))
 }
 
+func TestMem64SizeAndGrow(t *testing.T) {
+	vm := &vm{memory: newMemory(binary.MemType{Tag: binary.LimitsFlag64, Min: 2, Max: 4})}
+	instrTable[binary.MemorySize](vm, nil)
+	require.Equal(t, uint64(2), vm.popU64())
+
+	vm.pushU64(3)
+	instrTable[binary.MemoryGrow](vm, nil)
+	require.Equal(t, uint64(0xFFFFFFFFFFFFFFFF), vm.popU64())
+
+	vm.pushU64(2)
+	instrTable[binary.MemoryGrow](vm, nil)
+	require.Equal(t, uint64(2), vm.popU64())
+}
+
+func TestMem64Ops(t *testing.T) {
+	vm := &vm{memory: newMemory(binary.MemType{Tag: binary.LimitsFlag64, Min: 1})}
+	memArg := binary.MemArg{Offset: 0x10}
+
+	vm.pushU64(0x20)
+	vm.pushS64(-123)
+	instrTable[binary.I64Store](vm, memArg)
+	vm.pushU64(0x20)
+	instrTable[binary.I64Load](vm, memArg)
+	require.Equal(t, int64(-123), vm.popS64())
+
+	// address + offset overflows
+	vm.pushU64(0xFFFFFFFFFFFFFFFF)
+	require.PanicsWithValue(t, errOutOfBoundsMemory, func() {
+		instrTable[binary.I32Load](vm, memArg)
+	})
+}
+
 func testMemOp(t *testing.T, vm *vm, storeOp, loadOp byte,
 	offset, i uint32, val interface{}) {
 
-	memArg := binary.MemArg{Offset: offset}
+	memArg := binary.MemArg{Offset: uint64(offset)}
 
 	// store
 	vm.pushU32(i)
@@ -1,7 +1,6 @@
 package interpreter
 
 import (
-	"errors"
 	"math"
 	"math/bits"
 )
@@ -186,21 +185,33 @@ func i32Mul(vm *vm, _ interface{}) {
 }
 func i32DivS(vm *vm, _ interface{}) {
 	v2, v1 := vm.popS32(), vm.popS32()
+	if v2 == 0 {
+		panic(errIntDivideByZero)
+	}
 	if v1 == math.MinInt32 && v2 == -1 {
-		panic(errors.New("integer overflow"))
+		panic(errIntOverflow)
 	}
 	vm.pushS32(v1 / v2)
 }
 func i32DivU(vm *vm, _ interface{}) {
 	v2, v1 := vm.popU32(), vm.popU32()
+	if v2 == 0 {
+		panic(errIntDivideByZero)
+	}
 	vm.pushU32(v1 / v2)
 }
 func i32RemS(vm *vm, _ interface{}) {
 	v2, v1 := vm.popS32(), vm.popS32()
+	if v2 == 0 {
+		panic(errIntDivideByZero)
+	}
 	vm.pushS32(v1 % v2)
 }
 func i32RemU(vm *vm, _ interface{}) {
 	v2, v1 := vm.popU32(), vm.popU32()
+	if v2 == 0 {
+		panic(errIntDivideByZero)
+	}
 	vm.pushU32(v1 % v2)
 }
 func i32And(vm *vm, _ interface{}) {
@@ -260,21 +271,33 @@ func i64Mul(vm *vm, _ interface{}) {
 }
 func i64DivS(vm *vm, _ interface{}) {
 	v2, v1 := vm.popS64(), vm.popS64()
+	if v2 == 0 {
+		panic(errIntDivideByZero)
+	}
 	if v1 == math.MinInt64 && v2 == -1 {
-		panic(errors.New("integer overflow"))
+		panic(errIntOverflow)
 	}
 	vm.pushS64(v1 / v2)
 }
 func i64DivU(vm *vm, _ interface{}) {
 	v2, v1 := vm.popU64(), vm.popU64()
+	if v2 == 0 {
+		panic(errIntDivideByZero)
+	}
 	vm.pushU64(v1 / v2)
 }
 func i64RemS(vm *vm, _ interface{}) {
 	v2, v1 := vm.popS64(), vm.popS64()
+	if v2 == 0 {
+		panic(errIntDivideByZero)
+	}
 	vm.pushS64(v1 % v2)
 }
 func i64RemU(vm *vm, _ interface{}) {
 	v2, v1 := vm.popU64(), vm.popU64()
+	if v2 == 0 {
+		panic(errIntDivideByZero)
+	}
 	vm.pushU64(v1 % v2)
 }
 func i64And(vm *vm, _ interface{}) {
@@ -455,40 +478,40 @@ func i32WrapI64(vm *vm, _ interface{}) {
 func i32TruncF32S(vm *vm, _ interface{}) {
 	f := math.Trunc(float64(vm.popF32()))
 	if f > math.MaxInt32 || f < math.MinInt32 {
-		panic("integer overflow")
+		panic(errIntOverflow)
 	}
 	if math.IsNaN(f) {
-		panic("invalid conversion to integer")
+		panic(errInvalidConversion)
 	}
 	vm.pushS32(int32(f))
 }
 func i32TruncF32U(vm *vm, _ interface{}) {
 	f := math.Trunc(float64(vm.popF32()))
 	if f > math.MaxUint32 || f < 0 {
-		panic("integer overflow")
+		panic(errIntOverflow)
 	}
 	if math.IsNaN(f) {
-		panic("invalid conversion to integer")
+		panic(errInvalidConversion)
 	}
 	vm.pushU32(uint32(f))
 }
 func i32TruncF64S(vm *vm, _ interface{}) {
 	f := math.Trunc(vm.popF64())
 	if f > math.MaxInt32 || f < math.MinInt32 {
-		panic("integer overflow")
+		panic(errIntOverflow)
 	}
 	if math.IsNaN(f) {
-		panic("invalid conversion to integer")
+		panic(errInvalidConversion)
 	}
 	vm.pushS32(int32(f))
 }
 func i32TruncF64U(vm *vm, _ interface{}) {
 	f := math.Trunc(vm.popF64())
 	if f > math.MaxUint32 || f < 0 {
-		panic("integer overflow")
+		panic(errIntOverflow)
 	}
 	if math.IsNaN(f) {
-		panic("invalid conversion to integer")
+		panic(errInvalidConversion)
 	}
 	vm.pushU32(uint32(f))
 }
@@ -501,40 +524,40 @@ func i64ExtendI32U(vm *vm, _ interface{}) {
 func i64TruncF32S(vm *vm, _ interface{}) {
 	f := math.Trunc(float64(vm.popF32()))
 	if f >= math.MaxInt64 || f < math.MinInt64 {
-		panic("integer overflow")
+		panic(errIntOverflow)
 	}
 	if math.IsNaN(f) {
-		panic("invalid conversion to integer")
+		panic(errInvalidConversion)
 	}
 	vm.pushS64(int64(f))
 }
 func i64TruncF32U(vm *vm, _ interface{}) {
 	f := math.Trunc(float64(vm.popF32()))
 	if f >= math.MaxUint64 || f < 0 {
-		panic("integer overflow")
+		panic(errIntOverflow)
 	}
 	if math.IsNaN(f) {
-		panic("invalid conversion to integer")
+		panic(errInvalidConversion)
 	}
 	vm.pushU64(uint64(f))
 }
 func i64TruncF64S(vm *vm, _ interface{}) {
 	f := math.Trunc(vm.popF64())
 	if f >= math.MaxInt64 || f < math.MinInt64 {
-		panic("integer overflow")
+		panic(errIntOverflow)
 	}
 	if math.IsNaN(f) {
-		panic("invalid conversion to integer")
+		panic(errInvalidConversion)
 	}
 	vm.pushS64(int64(f))
 }
 func i64TruncF64U(vm *vm, _ interface{}) {
 	f := math.Trunc(vm.popF64())
 	if f >= math.MaxUint64 || f < 0 {
-		panic("integer overflow")
+		panic(errIntOverflow)
 	}
 	if math.IsNaN(f) {
-		panic("invalid conversion to integer")
+		panic(errInvalidConversion)
 	}
 	vm.pushU64(uint64(f))
 }
@@ -580,3 +603,19 @@ func f32ReinterpretI32(vm *vm, _ interface{}) {
 func f64ReinterpretI64(vm *vm, _ interface{}) {
 	//vm.pushF64(math.Float64frombits(vm.popU64()))
 }
+
+func i32Extend8S(vm *vm, _ interface{}) {
+	vm.pushS32(int32(int8(vm.popS32())))
+}
+func i32Extend16S(vm *vm, _ interface{}) {
+	vm.pushS32(int32(int16(vm.popS32())))
+}
+func i64Extend8S(vm *vm, _ interface{}) {
+	vm.pushS64(int64(int8(vm.popS64())))
+}
+func i64Extend16S(vm *vm, _ interface{}) {
+	vm.pushS64(int64(int16(vm.popS64())))
+}
+func i64Extend32S(vm *vm, _ interface{}) {
+	vm.pushS64(int64(int32(vm.popS64())))
+}
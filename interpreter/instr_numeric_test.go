@@ -222,6 +222,11 @@ func TestConversions(t *testing.T) {
 	testUnOp(t, binary.I64ReinterpretF64, 1.5, int64(0x3FF8_0000_0000_0000))
 	testUnOp(t, binary.F32ReinterpretI32, int32(0x3FC0_0000), float32(1.5))
 	testUnOp(t, binary.F64ReinterpretI64, int64(0x3FF8_0000_0000_0000), 1.5)
+	testUnOp(t, binary.I32Extend8S, int32(0x180), int32(-128))
+	testUnOp(t, binary.I32Extend16S, int32(0x1_8000), int32(-0x8000))
+	testUnOp(t, binary.I64Extend8S, int64(0x7F), int64(0x7F))
+	testUnOp(t, binary.I64Extend16S, int64(0xFFFF), int64(-1))
+	testUnOp(t, binary.I64Extend32S, int64(0x1_8000_0000), int64(-0x8000_0000))
 }
 
 func testI32UnOp(t *testing.T, opcode byte, b, c int32) {
@@ -0,0 +1,115 @@
+package interpreter
+
+import (
+	"reflect"
+	"unsafe"
+
+	"github.com/zxh0/wasm.go/instance"
+)
+
+/*
+references are kept on the operand stack as handles:
+0 is null, n+1 refers to vm.refs[n]. The functions of the vm
+keep their index, other functions and extern values are added once.
+*/
+
+// key of a function of another vm
+type vmFuncKey struct {
+	vm  *vm
+	idx int
+}
+
+// key of a value which is not comparable: its type and the address
+// of the value boxed in the interface, which is shared by the copies
+// of the interface, e.g. the elements of a table
+type boxKey struct {
+	t    reflect.Type
+	data unsafe.Pointer
+}
+
+func getBoxKey(ref interface{}) boxKey {
+	return boxKey{
+		t:    reflect.TypeOf(ref),
+		data: (*[2]unsafe.Pointer)(unsafe.Pointer(&ref))[1],
+	}
+}
+
+func (vm *vm) initRefs() {
+	vm.refs = make([]interface{}, len(vm.funcs))
+	for i, f := range vm.funcs {
+		vm.refs[i] = f
+	}
+	vm.handles = map[interface{}]uint64{}
+}
+
+func (vm *vm) pushRef(ref interface{}) {
+	vm.pushU64(vm.getHandle(ref))
+}
+func (vm *vm) getHandle(ref interface{}) uint64 {
+	if ref == nil {
+		return 0
+	}
+	key := ref
+	if vf, ok := ref.(vmFunc); ok {
+		if vf.vm == vm {
+			return uint64(vf.idx) + 1
+		}
+		key = vmFuncKey{vm: vf.vm, idx: vf.idx}
+	} else if !reflect.TypeOf(ref).Comparable() {
+		key = getBoxKey(ref)
+	}
+	if handle, ok := vm.handles[key]; ok {
+		return handle
+	}
+	vm.refs = append(vm.refs, ref)
+	vm.handles[key] = uint64(len(vm.refs))
+	return uint64(len(vm.refs))
+}
+func (vm *vm) popRef() interface{} {
+	return vm.getRef(vm.popU64())
+}
+func (vm *vm) popFuncRef() instance.Function {
+	f, _ := vm.popRef().(instance.Function)
+	return f
+}
+func (vm *vm) getRef(handle uint64) interface{} {
+	if handle == 0 {
+		return nil
+	}
+	return vm.refs[handle-1]
+}
+
+func refNull(vm *vm, _ interface{}) {
+	vm.pushU64(0)
+}
+
+func refIsNull(vm *vm, _ interface{}) {
+	vm.pushBool(vm.popU64() == 0)
+}
+
+func refFunc(vm *vm, args interface{}) {
+	vm.pushU64(uint64(args.(uint32)) + 1)
+}
+
+func refAsNonNull(vm *vm, _ interface{}) {
+	handle := vm.popU64()
+	if handle == 0 {
+		panic(errNullRef)
+	}
+	vm.pushU64(handle)
+}
+
+func brOnNull(vm *vm, args interface{}) {
+	if handle := vm.popU64(); handle == 0 {
+		br(vm, args)
+	} else {
+		vm.pushU64(handle)
+	}
+}
+
+func brOnNonNull(vm *vm, args interface{}) {
+	if handle := vm.popU64(); handle != 0 {
+		vm.pushU64(handle)
+		br(vm, args)
+	}
+}
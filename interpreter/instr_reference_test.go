@@ -0,0 +1,204 @@
+package interpreter
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zxh0/wasm.go/binary"
+	"github.com/zxh0/wasm.go/instance"
+	"github.com/zxh0/wasm.go/text"
+)
+
+func TestRefOps(t *testing.T) {
+	vm := &vm{}
+	instrTable[binary.RefNull](vm, binary.ValTypeFuncRef)
+	instrTable[binary.RefIsNull](vm, nil)
+	require.Equal(t, true, vm.popBool())
+
+	vm.pushRef(vmFunc{vm: vm})
+	instrTable[binary.RefAsNonNull](vm, nil)
+	instrTable[binary.RefIsNull](vm, nil)
+	require.Equal(t, false, vm.popBool())
+
+	vm.pushU64(0)
+	require.PanicsWithValue(t, errNullRef, func() {
+		instrTable[binary.RefAsNonNull](vm, nil)
+	})
+}
+
+func TestNullFuncRefTrap(t *testing.T) {
+	m, err := text.CompileModuleStr(`(module
+  (type $v2v (func))
+  (func (export "f") (call_ref $v2v (ref.null $v2v))))`)
+	require.NoError(t, err)
+	inst, err := NewInstance(*m, nil)
+	require.NoError(t, err)
+	_, err = inst.CallFunc("f")
+	require.IsType(t, &Trap{}, err)
+	require.Equal(t, "null function reference", err.(*Trap).Msg)
+}
+
+func TestCallRef(t *testing.T) {
+	i32 := binary.ValTypeI32
+	ref0 := binary.RefType(false, 0)
+	m := binary.Module{
+		TypeSec: []binary.FuncType{
+			{ParamTypes: []binary.ValType{i32}, ResultTypes: []binary.ValType{i32}},
+			{ParamTypes: []binary.ValType{ref0.AsNullable(), i32}, ResultTypes: []binary.ValType{i32}},
+			{ResultTypes: []binary.ValType{ref0}},
+		},
+		FuncSec: []binary.TypeIdx{0, 1, 2, 0},
+		CodeSec: []binary.Code{
+			{Expr: []binary.Instruction{ // inc
+				{Opcode: binary.LocalGet, Args: uint32(0)},
+				{Opcode: binary.I32Const, Args: int32(1)},
+				{Opcode: binary.I32Add},
+			}},
+			{Expr: []binary.Instruction{ // apply
+				{Opcode: binary.LocalGet, Args: uint32(1)},
+				{Opcode: binary.Block, Args: binary.BlockArgs{
+					RT: []binary.ValType{ref0},
+					Instrs: []binary.Instruction{
+						{Opcode: binary.LocalGet, Args: uint32(0)},
+						{Opcode: binary.BrOnNonNull, Args: uint32(0)},
+						{Opcode: binary.I32Const, Args: int32(-1)},
+						{Opcode: binary.Return},
+					},
+				}},
+				{Opcode: binary.CallRef, Args: uint32(0)},
+			}},
+			{Expr: []binary.Instruction{ // get
+				{Opcode: binary.RefFunc, Args: uint32(0)},
+			}},
+			{Expr: []binary.Instruction{ // tail
+				{Opcode: binary.LocalGet, Args: uint32(0)},
+				{Opcode: binary.RefFunc, Args: uint32(0)},
+				{Opcode: binary.ReturnCallRef, Args: uint32(0)},
+			}},
+		},
+		ExportSec: []binary.Export{
+			{Name: "apply", Desc: binary.ExportDesc{Tag: binary.ExportTagFunc, Idx: 1}},
+			{Name: "get", Desc: binary.ExportDesc{Tag: binary.ExportTagFunc, Idx: 2}},
+			{Name: "tail", Desc: binary.ExportDesc{Tag: binary.ExportTagFunc, Idx: 3}},
+		},
+	}
+
+	_, err := NewInstance(m, nil)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "undeclared function reference: 0")
+
+	m.ElemSec = []binary.Elem{{Flag: binary.ElemFlagDeclarative, Init: []binary.FuncIdx{0}}}
+	inst, err := NewInstance(m, nil)
+	require.NoError(t, err)
+
+	f, err := inst.CallFunc("get")
+	require.NoError(t, err)
+	inc := f.(instance.Function)
+	result, err := inc.Call(int32(41))
+	require.NoError(t, err)
+	require.Equal(t, int32(42), result)
+
+	result, err = inst.CallFunc("apply", inc, int32(1))
+	require.NoError(t, err)
+	require.Equal(t, int32(2), result)
+	result, err = inst.CallFunc("apply", nil, int32(1))
+	require.NoError(t, err)
+	require.Equal(t, int32(-1), result)
+
+	result, err = inst.CallFunc("tail", int32(9))
+	require.NoError(t, err)
+	require.Equal(t, int32(10), result)
+
+	// handles are reused
+	env := instance.NewNativeInstance()
+	env.RegisterFunc("dec", func(args ...interface{}) (interface{}, error) {
+		return args[0].(int32) - 1, nil
+	}, i32, i32)
+	dec := env.Get("dec").(instance.Function)
+	var wrapped instance.Function = wrappedFunc{dec}
+	refCount := 0
+	for i := 0; i < 3; i++ {
+		result, err = inst.CallFunc("apply", dec, int32(1))
+		require.NoError(t, err)
+		require.Equal(t, int32(0), result)
+		result, err = inst.CallFunc("apply", wrapped, int32(1))
+		require.NoError(t, err)
+		require.Equal(t, int32(0), result)
+		_, err = inst.CallFunc("apply", inc, int32(1))
+		require.NoError(t, err)
+		_, err = inst.CallFunc("get")
+		require.NoError(t, err)
+		if i == 0 {
+			refCount = len(inst.(*vm).refs)
+		}
+	}
+	require.Equal(t, len(m.FuncSec)+2, refCount)
+	require.Equal(t, refCount, len(inst.(*vm).refs))
+}
+
+// a function which is not comparable
+type wrappedFunc []instance.Function
+
+func (f wrappedFunc) Type() binary.FuncType {
+	return f[0].Type()
+}
+func (f wrappedFunc) Call(args ...interface{}) (interface{}, error) {
+	return f[0].Call(args...)
+}
+
+func TestRefSubtyping(t *testing.T) {
+	m := binary.Module{
+		TypeSec: []binary.FuncType{{}},
+		GlobalSec: []binary.Global{
+			{
+				Type: binary.GlobalType{ValType: binary.RefType(false, 0)},
+				Expr: []binary.Instruction{
+					{Opcode: binary.RefNull, Args: binary.RefType(true, 0)},
+				},
+			},
+		},
+	}
+	_, err := NewInstance(m, nil)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "type mismatch")
+
+	m.GlobalSec[0].Type.ValType = binary.ValTypeFuncRef
+	_, err = NewInstance(m, nil)
+	require.NoError(t, err)
+}
+
+func TestRefGlobalAcrossInstances(t *testing.T) {
+	newInstance := func(wat string, iMap instance.Map) instance.Instance {
+		m, err := text.CompileModuleStr(wat)
+		require.NoError(t, err)
+		inst, err := NewInstance(*m, iMap)
+		require.NoError(t, err)
+		return inst
+	}
+	call := func(inst instance.Instance, name string, arg int32) interface{} {
+		f, err := inst.CallFunc(name)
+		require.NoError(t, err)
+		result, err := f.(instance.Function).Call(arg)
+		require.NoError(t, err)
+		return result
+	}
+
+	a := newInstance(`(module
+  (func $inc (param i32) (result i32) (i32.add (local.get 0) (i32.const 1)))
+  (global (export "g") (mut funcref) (ref.func $inc))
+  (func (export "get") (result funcref) (global.get 0)))`, nil)
+	b := newInstance(`(module
+  (import "a" "g" (global $g (mut funcref)))
+  (func $dec (param i32) (result i32) (i32.sub (local.get 0) (i32.const 1)))
+  (elem declare func $dec)
+  (func (export "get") (result funcref) (global.get $g))
+  (func (export "set") (global.set $g (ref.func $dec))))`, instance.Map{"a": a})
+
+	require.Equal(t, int32(2), call(b, "get", 1))
+	_, err := b.CallFunc("set")
+	require.NoError(t, err)
+	require.Equal(t, int32(0), call(a, "get", 1))
+	g, err := a.GetGlobalValue("g")
+	require.NoError(t, err)
+	require.IsType(t, vmFunc{}, g)
+}
@@ -1,5 +1,7 @@
 package interpreter
 
+import "github.com/zxh0/wasm.go/instance"
+
 func localGet(vm *vm, args interface{}) {
 	idx := args.(uint32)
 	val := vm.getLocal(vm.local0Idx + idx)
@@ -17,13 +19,23 @@ func localTee(vm *vm, args interface{}) {
 	vm.setLocal(vm.local0Idx+idx, val)
 }
 
+// references are translated between handles of the vm and Go values,
+// since globals may be shared with other instances
 func globalGet(vm *vm, args interface{}) {
 	idx := args.(uint32)
+	if g, ok := vm.globals[idx].(instance.RefGlobal); ok {
+		vm.pushRef(g.GetRef())
+		return
+	}
 	val := vm.globals[idx].Get()
 	vm.pushU64(val)
 }
 func globalSet(vm *vm, args interface{}) {
 	idx := args.(uint32)
+	if g, ok := vm.globals[idx].(instance.RefGlobal); ok {
+		g.SetRef(vm.popRef())
+		return
+	}
 	val := vm.popU64()
 	vm.globals[idx].Set(val)
 }
@@ -0,0 +1,117 @@
+package interpreter
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/zxh0/wasm.go/binary"
+)
+
+// Listener is notified while an instance executes, e.g. to trace or
+// profile it. Args and results are Go values like in instance.Function,
+// the result is nil if the function has no result and a []interface{} if
+// it has multiple results. Callbacks run on the executing goroutine and
+// must not call into the instance.
+type Listener interface {
+	// EnterFunc is called before the first instruction of a function.
+	EnterFunc(fIdx int, args []interface{})
+	// ExitFunc is called when a function returns, or with a nil result
+	// when it is unwound by a trap or replaced by a tail call.
+	ExitFunc(fIdx int, result interface{})
+	// Instr is called before an instruction of a function is executed.
+	Instr(fIdx int, instr binary.Instruction)
+	// MemoryGrow is called after memory.grow, prevPages is
+	// math.MaxUint64 if the memory could not grow.
+	MemoryGrow(delta, prevPages uint64)
+	// HostCall is called after an imported function returns.
+	HostCall(fIdx int, args []interface{}, result interface{}, err error)
+}
+
+// NopListener ignores all events, embed it to implement only some callbacks.
+type NopListener struct{}
+
+func (NopListener) EnterFunc(fIdx int, args []interface{})                               {}
+func (NopListener) ExitFunc(fIdx int, result interface{})                                {}
+func (NopListener) Instr(fIdx int, instr binary.Instruction)                             {}
+func (NopListener) MemoryGrow(delta, prevPages uint64)                                   {}
+func (NopListener) HostCall(fIdx int, args []interface{}, result interface{}, err error) {}
+
+// Tracer is a Listener which prints calls, and optionally instructions,
+// indented by call depth.
+type Tracer struct {
+	w      io.Writer
+	names  binary.NameMap
+	instrs bool
+	depth  int
+}
+
+// NewTracer creates a Tracer for module m, functions are printed with
+// their names from the name section if it has one.
+func NewTracer(w io.Writer, m binary.Module, instrs bool) *Tracer {
+	t := &Tracer{w: w, instrs: instrs}
+	if names, err := m.GetNameSec(); err == nil && names != nil {
+		t.names = names.FuncNames
+	}
+	return t
+}
+
+func (t *Tracer) EnterFunc(fIdx int, args []interface{}) {
+	t.printf("call %s%s", t.getFuncName(fIdx), getArgsStr(args))
+	t.depth++
+}
+
+func (t *Tracer) ExitFunc(fIdx int, result interface{}) {
+	t.depth--
+	if result != nil {
+		t.printf("return %s: %v", t.getFuncName(fIdx), result)
+	} else {
+		t.printf("return %s", t.getFuncName(fIdx))
+	}
+}
+
+func (t *Tracer) Instr(fIdx int, instr binary.Instruction) {
+	if !t.instrs {
+		return
+	}
+	if instr.Args != nil {
+		t.printf("%s %v", instr, instr.Args)
+	} else {
+		t.printf("%s", instr)
+	}
+}
+
+func (t *Tracer) MemoryGrow(delta, prevPages uint64) {
+	t.printf("memory.grow %d: %d", delta, int64(prevPages))
+}
+
+func (t *Tracer) HostCall(fIdx int, args []interface{}, result interface{}, err error) {
+	s := "host " + t.getFuncName(fIdx) + getArgsStr(args)
+	if err != nil {
+		t.printf("%s: error: %v", s, err)
+	} else if result != nil {
+		t.printf("%s: %v", s, result)
+	} else {
+		t.printf("%s", s)
+	}
+}
+
+func (t *Tracer) getFuncName(fIdx int) string {
+	if name, ok := t.names[uint32(fIdx)]; ok {
+		return name
+	}
+	return fmt.Sprintf("func[%d]", fIdx)
+}
+
+func (t *Tracer) printf(format string, a ...interface{}) {
+	fmt.Fprint(t.w, strings.Repeat("  ", t.depth))
+	fmt.Fprintf(t.w, format+"\n", a...)
+}
+
+func getArgsStr(args []interface{}) string {
+	strs := make([]string, len(args))
+	for i, arg := range args {
+		strs[i] = fmt.Sprint(arg)
+	}
+	return "(" + strings.Join(strs, ", ") + ")"
+}
@@ -0,0 +1,159 @@
+package interpreter
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zxh0/wasm.go/binary"
+	"github.com/zxh0/wasm.go/instance"
+	"github.com/zxh0/wasm.go/text"
+)
+
+const listenerTestWAT = `(module
+  (import "env" "double" (func $double (param i32) (result i32)))
+  (memory 1)
+  (func $abs (param $x f64) (result f64)
+    local.get $x
+    f64.const 0
+    f64.ge
+    if
+      local.get $x
+      return
+    end
+    local.get $x
+    f64.neg)
+  (func $main (export "main") (param $n i32) (result i32)
+    f64.const -1.5
+    call $abs
+    drop
+    i32.const 1
+    memory.grow
+    drop
+    local.get $n
+    call $double)
+  (func (export "trap")
+    unreachable))`
+
+type eventRecorder struct {
+	NopListener
+	events []string
+	instrs int
+}
+
+func (r *eventRecorder) EnterFunc(fIdx int, args []interface{}) {
+	r.events = append(r.events, fmt.Sprintf("enter %d %v", fIdx, args))
+}
+func (r *eventRecorder) ExitFunc(fIdx int, result interface{}) {
+	r.events = append(r.events, fmt.Sprintf("exit %d %v", fIdx, result))
+}
+func (r *eventRecorder) Instr(fIdx int, instr binary.Instruction) {
+	r.instrs++
+}
+func (r *eventRecorder) MemoryGrow(delta, prevPages uint64) {
+	r.events = append(r.events, fmt.Sprintf("grow %d %d", delta, prevPages))
+}
+func (r *eventRecorder) HostCall(fIdx int, args []interface{}, result interface{}, err error) {
+	r.events = append(r.events, fmt.Sprintf("host %d %v %v", fIdx, args, result))
+}
+
+func newListenerTestInstance(t *testing.T, l Listener) instance.Instance {
+	m, err := text.CompileModuleStrWithOptions(listenerTestWAT,
+		text.CompileOptions{Names: true})
+	require.NoError(t, err)
+	env := instance.NewNativeInstance()
+	env.RegisterFunc("double", func(args ...interface{}) (interface{}, error) {
+		return args[0].(int32) * 2, nil
+	}, binary.ValTypeI32, binary.ValTypeI32)
+	inst, err := NewInstanceWithOptions(*m, instance.Map{"env": env},
+		Options{Listener: l})
+	require.NoError(t, err)
+	return inst
+}
+
+func TestListener(t *testing.T) {
+	r := &eventRecorder{}
+	inst := newListenerTestInstance(t, r)
+	result, err := inst.CallFunc("main", int32(21))
+	require.NoError(t, err)
+	require.Equal(t, int32(42), result)
+	require.Equal(t, []string{
+		"enter 2 [21]",
+		"enter 1 [-1.5]",
+		"exit 1 1.5",
+		"grow 1 1",
+		"host 0 [21] 42",
+		"exit 2 42",
+	}, r.events)
+	require.Equal(t, 14, r.instrs)
+
+	r.events = nil
+	_, err = inst.CallFunc("trap")
+	require.Error(t, err)
+	require.Equal(t, []string{"enter 3 []", "exit 3 <nil>"}, r.events)
+}
+
+func TestTracer(t *testing.T) {
+	m, err := text.CompileModuleStrWithOptions(listenerTestWAT,
+		text.CompileOptions{Names: true})
+	require.NoError(t, err)
+	buf := &bytes.Buffer{}
+	inst := newListenerTestInstance(t, NewTracer(buf, *m, false))
+	_, err = inst.CallFunc("main", int32(21))
+	require.NoError(t, err)
+	require.Equal(t, `call main(21)
+  call abs(-1.5)
+  return abs: 1.5
+  memory.grow 1: 1
+  host double(21): 42
+return main: 42
+`, buf.String())
+
+	buf.Reset()
+	inst = newListenerTestInstance(t, NewTracer(buf, *m, true))
+	_, err = inst.CallFunc("trap")
+	require.Error(t, err)
+	require.Equal(t, "call func[3]()\n  unreachable\nreturn func[3]\n", buf.String())
+}
+
+func TestListenerTailCall(t *testing.T) {
+	m, err := text.CompileModuleStr(`(module
+  (type $t (func (param i32) (result i32)))
+  (func $count (type $t)
+    local.get 0
+    i32.eqz
+    if (result i32)
+      i32.const 0
+    else
+      local.get 0
+      i32.const 1
+      i32.sub
+      ref.func $count
+      return_call_ref $t
+    end)
+  (elem declare func $count)
+  (func (export "main") (result i32)
+    i32.const 2
+    ref.func $count
+    call_ref $t
+    i32.const 1
+    i32.add))`)
+	require.NoError(t, err)
+	r := &eventRecorder{}
+	inst, err := NewInstanceWithOptions(*m, nil, Options{Listener: r})
+	require.NoError(t, err)
+	result, err := inst.CallFunc("main")
+	require.NoError(t, err)
+	require.Equal(t, int32(1), result)
+	require.Equal(t, []string{
+		"enter 1 []",
+		"enter 0 [2]",
+		"exit 0 <nil>",
+		"enter 0 [1]",
+		"exit 0 <nil>",
+		"enter 0 [0]",
+		"exit 0 0",
+		"exit 1 1",
+	}, r.events)
+}
@@ -0,0 +1,257 @@
+package interpreter
+
+import (
+	"compress/gzip"
+	"fmt"
+	"io"
+	"sort"
+	"time"
+
+	"github.com/zxh0/wasm.go/binary"
+)
+
+// Profiler is a Listener which counts the executed instructions and
+// the calls per call stack. The profile is written in the pprof format,
+// so "go tool pprof" shows the hot functions and call edges of the guest.
+type Profiler struct {
+	NopListener
+	module binary.Module
+	root   *profNode
+	cur    *profNode
+	start  time.Time
+}
+
+// a node of the call tree
+type profNode struct {
+	fIdx     int
+	parent   *profNode
+	children map[int]*profNode
+	instrs   int64 // self
+	calls    int64
+}
+
+func NewProfiler(m binary.Module) *Profiler {
+	root := &profNode{fIdx: -1}
+	return &Profiler{
+		module: m,
+		root:   root,
+		cur:    root,
+		start:  time.Now(),
+	}
+}
+
+func (p *Profiler) EnterFunc(fIdx int, args []interface{}) {
+	p.cur = p.cur.getChild(fIdx)
+	p.cur.calls++
+}
+
+func (p *Profiler) ExitFunc(fIdx int, result interface{}) {
+	if p.cur != p.root {
+		p.cur = p.cur.parent
+	}
+}
+
+func (p *Profiler) Instr(fIdx int, instr binary.Instruction) {
+	p.cur.instrs++
+}
+
+func (p *Profiler) HostCall(fIdx int, args []interface{}, result interface{}, err error) {
+	p.cur.getChild(fIdx).calls++
+}
+
+func (n *profNode) getChild(fIdx int) *profNode {
+	if child := n.children[fIdx]; child != nil {
+		return child
+	}
+	if n.children == nil {
+		n.children = map[int]*profNode{}
+	}
+	child := &profNode{fIdx: fIdx, parent: n}
+	n.children[fIdx] = child
+	return child
+}
+
+/* pprof */
+
+// field numbers of profile.proto
+const (
+	profSampleType        = 1
+	profSample            = 2
+	profLocation          = 4
+	profFunction          = 5
+	profStringTable       = 6
+	profTimeNanos         = 9
+	profDurationNanos     = 10
+	profPeriodType        = 11
+	profPeriod            = 12
+	profDefaultSampleType = 14
+
+	valueTypeType = 1
+	valueTypeUnit = 2
+
+	sampleLocationID = 1
+	sampleValue      = 2
+
+	locationID   = 1
+	locationLine = 4
+	lineFuncID   = 1
+
+	functionID         = 1
+	functionName       = 2
+	functionSystemName = 3
+)
+
+// WriteProfile writes the gzipped pprof profile to w.
+func (p *Profiler) WriteProfile(w io.Writer) error {
+	zw := gzip.NewWriter(w)
+	if _, err := zw.Write(p.encode()); err != nil {
+		return err
+	}
+	return zw.Close()
+}
+
+func (p *Profiler) encode() []byte {
+	strs := map[string]int64{"": 0}
+	strTable := []string{""}
+	str := func(s string) int64 {
+		if idx, ok := strs[s]; ok {
+			return idx
+		}
+		strs[s] = int64(len(strTable))
+		strTable = append(strTable, s)
+		return strs[s]
+	}
+	valueType := func(typ, unit string) []byte {
+		vt := &protoBuf{}
+		vt.int64(valueTypeType, str(typ))
+		vt.int64(valueTypeUnit, str(unit))
+		return vt.data
+	}
+
+	buf := &protoBuf{}
+	buf.bytes(profSampleType, valueType("instructions", "count"))
+	buf.bytes(profSampleType, valueType("calls", "count"))
+
+	// one location per function, id is fIdx+1
+	funcs := map[int]bool{}
+	var walk func(n *profNode, stack []uint64)
+	walk = func(n *profNode, stack []uint64) {
+		stack = append([]uint64{uint64(n.fIdx + 1)}, stack...) // leaf first
+		funcs[n.fIdx] = true
+		if n.instrs > 0 || n.calls > 0 {
+			sample := &protoBuf{}
+			sample.packed(sampleLocationID, stack)
+			sample.packed(sampleValue, []uint64{uint64(n.instrs), uint64(n.calls)})
+			buf.bytes(profSample, sample.data)
+		}
+		for _, child := range n.getSortedChildren() {
+			walk(child, stack)
+		}
+	}
+	for _, child := range p.root.getSortedChildren() {
+		walk(child, nil)
+	}
+
+	names := p.getFuncNames()
+	for fIdx := 0; fIdx < len(names); fIdx++ {
+		if !funcs[fIdx] {
+			continue
+		}
+		line := &protoBuf{}
+		line.uint64(lineFuncID, uint64(fIdx+1))
+		loc := &protoBuf{}
+		loc.uint64(locationID, uint64(fIdx+1))
+		loc.bytes(locationLine, line.data)
+		buf.bytes(profLocation, loc.data)
+
+		fn := &protoBuf{}
+		fn.uint64(functionID, uint64(fIdx+1))
+		fn.int64(functionName, str(names[fIdx]))
+		fn.int64(functionSystemName, str(names[fIdx]))
+		buf.bytes(profFunction, fn.data)
+	}
+
+	buf.int64(profTimeNanos, p.start.UnixNano())
+	buf.int64(profDurationNanos, int64(time.Since(p.start)))
+	buf.bytes(profPeriodType, valueType("instructions", "count"))
+	buf.int64(profPeriod, 1)
+	buf.int64(profDefaultSampleType, str("instructions"))
+	for _, s := range strTable {
+		buf.bytes(profStringTable, []byte(s))
+	}
+	return buf.data
+}
+
+func (n *profNode) getSortedChildren() []*profNode {
+	children := make([]*profNode, 0, len(n.children))
+	for _, child := range n.children {
+		children = append(children, child)
+	}
+	sort.Slice(children, func(i, j int) bool {
+		return children[i].fIdx < children[j].fIdx
+	})
+	return children
+}
+
+// names from the name section, imports are named module.name,
+// other functions by export name or func[N]
+func (p *Profiler) getFuncNames() []string {
+	var names []string
+	for _, imp := range p.module.ImportSec {
+		if imp.Desc.Tag == binary.ImportTagFunc {
+			names = append(names, imp.Module+"."+imp.Name)
+		}
+	}
+	for range p.module.FuncSec {
+		names = append(names, fmt.Sprintf("func[%d]", len(names)))
+	}
+	for _, exp := range p.module.ExportSec {
+		if exp.Desc.Tag == binary.ExportTagFunc && int(exp.Desc.Idx) < len(names) {
+			names[exp.Desc.Idx] = exp.Name
+		}
+	}
+	if nameSec, err := p.module.GetNameSec(); err == nil && nameSec != nil {
+		for fIdx, name := range nameSec.FuncNames {
+			if int(fIdx) < len(names) {
+				names[fIdx] = name
+			}
+		}
+	}
+	return names
+}
+
+// minimal protobuf encoder
+type protoBuf struct {
+	data []byte
+}
+
+func (b *protoBuf) varint(v uint64) {
+	for v >= 0x80 {
+		b.data = append(b.data, byte(v)|0x80)
+		v >>= 7
+	}
+	b.data = append(b.data, byte(v))
+}
+
+func (b *protoBuf) uint64(field int, v uint64) {
+	b.varint(uint64(field) << 3) // wire type 0
+	b.varint(v)
+}
+
+func (b *protoBuf) int64(field int, v int64) {
+	b.uint64(field, uint64(v))
+}
+
+func (b *protoBuf) bytes(field int, data []byte) {
+	b.varint(uint64(field)<<3 | 2) // wire type 2
+	b.varint(uint64(len(data)))
+	b.data = append(b.data, data...)
+}
+
+func (b *protoBuf) packed(field int, vals []uint64) {
+	packed := &protoBuf{}
+	for _, v := range vals {
+		packed.varint(v)
+	}
+	b.bytes(field, packed.data)
+}
@@ -0,0 +1,47 @@
+package interpreter
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zxh0/wasm.go/text"
+)
+
+func TestProfiler(t *testing.T) {
+	m, err := text.CompileModuleStrWithOptions(listenerTestWAT,
+		text.CompileOptions{Names: true})
+	require.NoError(t, err)
+	p := NewProfiler(*m)
+	inst := newListenerTestInstance(t, p)
+	for i := 0; i < 2; i++ {
+		_, err = inst.CallFunc("main", int32(1))
+		require.NoError(t, err)
+	}
+	_, err = inst.CallFunc("trap")
+	require.Error(t, err)
+	require.Equal(t, p.root, p.cur)
+
+	main := p.root.children[2]
+	require.Equal(t, int64(2), main.calls)
+	require.Equal(t, int64(16), main.instrs)
+	require.Equal(t, int64(2), main.children[1].calls) // abs
+	require.Equal(t, int64(12), main.children[1].instrs)
+	require.Equal(t, int64(2), main.children[0].calls) // double
+	require.Equal(t, int64(0), main.children[0].instrs)
+	require.Equal(t, int64(1), p.root.children[3].instrs)
+	require.Equal(t, []string{"double", "abs", "main", "trap"}, p.getFuncNames())
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, p.WriteProfile(buf))
+	zr, err := gzip.NewReader(buf)
+	require.NoError(t, err)
+	data, err := ioutil.ReadAll(zr)
+	require.NoError(t, err)
+	require.Equal(t, p.encode()[:20], data[:20])
+	for _, s := range []string{"instructions", "calls", "double", "abs", "main"} {
+		require.Contains(t, string(data), s)
+	}
+}
@@ -0,0 +1,53 @@
+package interpreter
+
+import "strings"
+
+// Trap is a runtime error, with the location of the instruction
+// which caused it, and a symbolized backtrace if the module has
+// DWARF debug info.
+type Trap struct {
+	Msg       string
+	Location  string   // e.g. func[3]+0x2a
+	Backtrace []string // e.g. src/lib.rs:42 in parse_header
+}
+
+func (t *Trap) Error() string {
+	s := t.Msg
+	if t.Location != "" {
+		s = t.Location + ": " + s
+	}
+	if len(t.Backtrace) > 0 {
+		s += "\n\tat " + strings.Join(t.Backtrace, "\n\tat ")
+	}
+	return s
+}
+
+// trapError is panicked by instructions, the call which
+// trapped turns it into a *Trap with the location
+type trapError string
+
+func (e trapError) Error() string {
+	return string(e)
+}
+
+const (
+	errUnreachable       = trapError("unreachable")
+	errIntOverflow       = trapError("integer overflow")
+	errIntDivideByZero   = trapError("integer divide by zero")
+	errInvalidConversion = trapError("invalid conversion to integer")
+	errOutOfBoundsMemory = trapError("out of bounds memory access")
+	errUndefinedElem     = trapError("undefined element")
+	errUninitializedElem = trapError("uninitialized element")
+	errIndirectCallType  = trapError("indirect call type mismatch")
+	errNullFuncRef       = trapError("null function reference")
+	errNullRef           = trapError("null reference")
+)
+
+// errors returned by host functions trap the calling instance,
+// traps of other instances are passed through with their location
+func trapHostError(err error) {
+	if t, ok := err.(*Trap); ok {
+		panic(t)
+	}
+	panic(trapError(err.Error()))
+}
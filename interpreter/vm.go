@@ -1,22 +1,15 @@
 package interpreter
 
 import (
-	"errors"
 	"fmt"
 	"math"
-	"strings"
 
 	"github.com/zxh0/wasm.go/binary"
+	"github.com/zxh0/wasm.go/binary/dwarf"
 	"github.com/zxh0/wasm.go/instance"
 	"github.com/zxh0/wasm.go/validator"
 )
 
-const (
-	DebugNone  = 0
-	DebugCall  = 1
-	DebugInstr = 2
-)
-
 var _ instance.Instance = (*vm)(nil)
 
 type vm struct {
@@ -28,29 +21,59 @@ type vm struct {
 	table   instance.Table
 	globals []instance.Global
 	funcs   []vmFunc
+	refs    []interface{}
+	handles map[interface{}]uint64 // of functions of other instances
 
 	local0Idx uint32
-	debug     byte
+	debugger  *Debugger // nil if not debugging
+	listener  Listener  // nil if not listening
+	dbgInfo   *dwarf.Data
+	dbgLoaded bool
+}
+
+// Options of NewInstanceWithOptions.
+type Options struct {
+	Listener Listener // notified of the execution, including the start function
 }
 
 func NewInstance(m binary.Module, instances instance.Map) (instance.Instance, error) {
-	if err, _ := validator.Validate(m); err != nil {
+	return NewInstanceWithOptions(m, instances, Options{})
+}
+
+func NewInstanceWithOptions(m binary.Module, instances instance.Map,
+	opts Options) (instance.Instance, error) {
+
+	vm, err := newVM(m, instances)
+	if err != nil {
 		return nil, err
 	}
+	vm.listener = opts.Listener
+	if err := vm.execStartFunc(); err != nil {
+		return nil, err
+	}
+	return vm, nil
+}
 
-	vm := &vm{module: m, debug: DebugNone}
+// the start function is not executed
+func newVM(m binary.Module, instances instance.Map) (*vm, error) {
+	// features are restricted by whoever decoded the module,
+	// the interpreter runs everything it implements
+	if err, _ := validator.ValidateWithOptions(m,
+		validator.Options{Features: binary.AllFeatures}); err != nil {
+		return nil, err
+	}
+
+	vm := &vm{module: m}
 	if err := vm.linkImports(instances); err != nil {
 		return nil, err
 	}
 
 	vm.initFuncs()
+	vm.initRefs()
 	if err := vm.initTableAndMem(); err != nil {
 		return nil, err
 	}
 	vm.initGlobals()
-	if err := vm.execStartFunc(); err != nil {
-		return nil, err
-	}
 	return vm, nil
 }
 
@@ -84,7 +107,7 @@ func (vm *vm) linkImport(m instance.Instance, imp binary.Import) error {
 			if isFuncTypeMatch(expectedFT, x.Type()) {
 				typeMatched = true
 				vm.funcs = append(vm.funcs,
-					newExternalFunc(vm, expectedFT, x))
+					newExternalFunc(vm, len(vm.funcs), expectedFT, x))
 			}
 		}
 	case instance.Table:
@@ -103,7 +126,9 @@ func (vm *vm) linkImport(m instance.Instance, imp binary.Import) error {
 		}
 	case instance.Global:
 		if imp.Desc.Tag == binary.ImportTagGlobal {
-			if isGlobalTypeMatch(imp.Desc.Global, x.Type()) {
+			_, isRef := x.(instance.RefGlobal)
+			if isGlobalTypeMatch(imp.Desc.Global, x.Type()) &&
+				isRef == x.Type().ValType.IsRef() {
 				typeMatched = true
 				vm.globals = append(vm.globals, x)
 			}
@@ -123,7 +148,8 @@ func (vm *vm) initFuncs() {
 	for i, sigIdx := range vm.module.FuncSec {
 		sig := vm.module.TypeSec[sigIdx]
 		code := vm.module.CodeSec[i]
-		vm.funcs = append(vm.funcs, newInternalFunc(vm, sig, code))
+		vm.funcs = append(vm.funcs,
+			newInternalFunc(vm, len(vm.funcs), sig, code))
 	}
 }
 
@@ -132,7 +158,11 @@ func (vm *vm) initTableAndMem() error {
 		vm.table = newTable(vm.module.TableSec[0])
 	}
 	if len(vm.module.MemSec) > 0 {
-		vm.memory = newMemory(vm.module.MemSec[0])
+		mt := vm.module.MemSec[0]
+		if mt.Is64() && mt.Min > maxMem64PageCount {
+			return fmt.Errorf("memory size exceeds host limit")
+		}
+		vm.memory = newMemory(mt)
 	}
 	elemOffsets, err := vm.calcElemOffsets()
 	if err != nil {
@@ -149,6 +179,9 @@ func (vm *vm) initTableAndMem() error {
 func (vm *vm) calcElemOffsets() ([]uint32, error) {
 	offsets := make([]uint32, len(vm.module.ElemSec))
 	for i, elem := range vm.module.ElemSec {
+		if elem.IsDeclarative() {
+			continue
+		}
 		vm.execConstExpr(elem.Offset)
 		offset := vm.popU32()
 		dataLen := len(elem.Init)
@@ -167,11 +200,11 @@ func (vm *vm) calcDataOffsets() ([]uint64, error) {
 	offsets := make([]uint64, len(vm.module.DataSec))
 	for i, data := range vm.module.DataSec {
 		vm.execConstExpr(data.Offset)
-		offset := uint64(vm.popU32())
+		offset := vm.popAddr()
 		dataLen := uint64(len(data.Init))
-		upperBound := uint64(vm.memory.Type().Min) * binary.PageSize
+		upperBound := vm.memory.Size() * binary.PageSize
 		if offset > 0 || dataLen > 0 {
-			if offset+dataLen > upperBound {
+			if offset > upperBound || dataLen > upperBound-offset {
 				return nil, fmt.Errorf("data segment does not fit")
 			}
 		}
@@ -182,6 +215,9 @@ func (vm *vm) calcDataOffsets() ([]uint64, error) {
 }
 func (vm *vm) initTable(offsets []uint32) {
 	for i, elem := range vm.module.ElemSec {
+		if elem.IsDeclarative() {
+			continue
+		}
 		for j, fIdx := range elem.Init {
 			offset := offsets[i] + uint32(j)
 			f := vm.funcs[fIdx]
@@ -202,8 +238,11 @@ func (vm *vm) initGlobals() {
 			vm.execConstExpr(g.Expr)
 			initVal = vm.popU64()
 		}
-		g := newGlobal(g.Type, initVal)
-		vm.globals = append(vm.globals, g)
+		if g.Type.ValType.IsRef() {
+			vm.globals = append(vm.globals, newRefGlobal(g.Type, vm.getRef(initVal)))
+		} else {
+			vm.globals = append(vm.globals, newGlobal(g.Type, initVal))
+		}
 	}
 }
 
@@ -238,15 +277,13 @@ func (vm *vm) exitBlock() {
 	vm.clearBlock(bf)
 }
 func (vm *vm) clearBlock(bf *blockFrame) {
-	var result uint64
-	if len(bf.rt) > 0 {
-		result = vm.popU64()
-	}
+	results := vm.popU64s(len(bf.rt))
 	for vm.stackSize() > bf.bp {
 		vm.popU64()
 	}
-	if len(bf.rt) > 0 {
-		vm.pushU64(result)
+	vm.pushU64s(results)
+	if bf.bt == btFunc && vm.listener != nil {
+		vm.listener.ExitFunc(bf.fIdx, vm.getResult(bf.rt, results))
 	}
 	if bf.bt == btFunc && vm.blockDepth() > 0 {
 		vm.local0Idx = uint32(vm.topFuncFrame().bp)
@@ -258,27 +295,96 @@ func (vm *vm) clearBlock(bf *blockFrame) {
 func (vm *vm) safeCallFunc(f vmFunc,
 	args []interface{}) (result interface{}, err error) {
 
+	stackSize, blockDepth := vm.stackSize(), vm.blockDepth()
 	defer func() {
 		if _err := recover(); _err != nil {
 			switch x := _err.(type) {
-			case error:
+			case *Trap:
 				err = x
-			case string:
-				err = errors.New(x) // TODO
+			case trapError:
+				err = vm.trap(string(x))
 			default:
-				panic(err)
+				panic(_err) // a bug, not a trap
 			}
+			vm.unwind(stackSize, blockDepth)
 		}
 	}()
 
-	if vm.debug >= DebugCall {
-		fmt.Printf("safe call! %v\n", f) // TODO
+	result = vm.callFunc(f, args)
+	return
+}
+
+// drops the frames of a trapped call
+func (vm *vm) unwind(stackSize, blockDepth int) {
+	for i := len(vm.frames) - 1; i >= blockDepth; i-- {
+		if bf := vm.frames[i]; bf.bt == btFunc && vm.listener != nil {
+			vm.listener.ExitFunc(bf.fIdx, nil)
+		}
+	}
+	vm.operandStack.data = vm.operandStack.data[:stackSize]
+	vm.frames = vm.frames[:blockDepth]
+	if fp := vm.topFuncFrame(); fp != nil {
+		vm.local0Idx = uint32(fp.bp)
 	}
+}
 
-	result = vm.callFunc(f, args)
+func (vm *vm) trap(msg string) *Trap {
+	return &Trap{
+		Msg:       msg,
+		Location:  vm.location(),
+		Backtrace: vm.backtrace(),
+	}
+}
+
+// symbolized call stack, innermost first
+func (vm *vm) backtrace() (bt []string) {
+	dbgInfo := vm.getDebugInfo()
+	if dbgInfo == nil {
+		return nil
+	}
+
+	var instr *binary.Instruction
+	for i := len(vm.frames) - 1; i >= 0; i-- {
+		bf := vm.frames[i]
+		if instr == nil && bf.pc > 0 {
+			instr = &bf.instrs[bf.pc-1]
+		}
+		if bf.bt == btFunc {
+			if instr != nil {
+				if loc, ok := dbgInfo.Lookup(instr.Offset); ok {
+					bt = append(bt, loc.String())
+				} else {
+					code := vm.funcs[bf.fIdx].code
+					bt = append(bt, code.Location(bf.fIdx, *instr))
+				}
+			}
+			instr = nil
+		}
+	}
 	return
 }
 
+func (vm *vm) getDebugInfo() *dwarf.Data {
+	if !vm.dbgLoaded {
+		vm.dbgInfo, _ = dwarf.New(vm.module)
+		vm.dbgLoaded = true
+	}
+	return vm.dbgInfo
+}
+
+// returns location of the executing instruction, e.g. func[3]+0x2a
+func (vm *vm) location() string {
+	if vm.blockDepth() == 0 {
+		return ""
+	}
+	bf := vm.topBlockFrame()
+	ff := vm.topFuncFrame()
+	if ff == nil || bf.pc == 0 {
+		return ""
+	}
+	return vm.funcs[ff.fIdx].code.Location(ff.fIdx, bf.instrs[bf.pc-1])
+}
+
 func (vm *vm) callFunc(f vmFunc, args []interface{}) interface{} {
 	vm.pushArgs(f._type, args)
 	callFunc(vm, f)
@@ -296,6 +402,12 @@ func (vm *vm) loop() {
 			vm.exitBlock()
 		} else {
 			instr := frame.instrs[frame.pc]
+			if vm.debugger != nil {
+				vm.debugger.beforeInstr(instr)
+			}
+			if vm.listener != nil {
+				vm.listener.Instr(vm.topFuncFrame().fIdx, instr)
+			}
 			frame.pc++
 			vm.execInstr(instr)
 		}
@@ -303,35 +415,18 @@ func (vm *vm) loop() {
 }
 
 func (vm *vm) execInstr(instr binary.Instruction) {
-	vm.logInstr(instr)
 	instrTable[instr.Opcode](vm, instr.Args)
 }
 
-func (vm *vm) logInstr(instr binary.Instruction) {
-	if vm.debug > DebugNone {
-		fmt.Print(strings.Repeat(">", vm.blockDepth()))
-		if instr.Opcode != binary.Call {
-			if vm.debug >= DebugInstr {
-				fmt.Printf("%s %v\n", instr.String(), instr.Args)
-			}
-		} else {
-			f := vm.funcs[instr.Args.(uint32)]
-			fmt.Printf("call func#%d(", instr.Args)
-			if n := len(f._type.ParamTypes); n > 0 {
-				stack := vm.operandStack.data
-				fmt.Print(stack[len(stack)-n:])
-			}
-			fmt.Println(")")
-		}
-	}
-}
-
 func (vm *vm) pushArgs(ft binary.FuncType, args []interface{}) {
 	if len(ft.ParamTypes) != len(args) {
-		panic(fmt.Errorf("param count: %d, arg count: %d",
-			len(ft.ParamTypes), len(args)))
+		panic(trapError(fmt.Sprintf("param count: %d, arg count: %d",
+			len(ft.ParamTypes), len(args))))
 	}
 	for i, vt := range ft.ParamTypes {
+		if !isValueOf(vt, args[i]) {
+			panic(trapError(fmt.Sprintf("arg[%d]: %T is not %s", i, args[i], vt)))
+		}
 		switch vt {
 		case binary.ValTypeI32:
 			vm.pushS32(args[i].(int32))
@@ -342,25 +437,71 @@ func (vm *vm) pushArgs(ft binary.FuncType, args []interface{}) {
 		case binary.ValTypeF64:
 			vm.pushF64(args[i].(float64))
 		default:
-			panic("unreachable")
+			if !vt.IsRef() {
+				panic("unreachable")
+			}
+			vm.pushRef(args[i])
 		}
 	}
 }
+
+// whether arg is the Go value of a wasm value of type vt
+func isValueOf(vt binary.ValType, arg interface{}) bool {
+	switch arg.(type) {
+	case int32:
+		return vt == binary.ValTypeI32
+	case int64:
+		return vt == binary.ValTypeI64
+	case float32:
+		return vt == binary.ValTypeF32
+	case float64:
+		return vt == binary.ValTypeF64
+	default:
+		return vt.IsRef()
+	}
+}
+
 func (vm *vm) popResult(ft binary.FuncType) interface{} {
-	if len(ft.ResultTypes) == 0 {
+	return vm.getResult(ft.ResultTypes, vm.popU64s(len(ft.ResultTypes)))
+}
+
+// nil if there is no result, multiple results are returned as []interface{}
+func (vm *vm) getResult(rt []binary.ValType, bits []uint64) interface{} {
+	switch len(rt) {
+	case 0:
 		return nil
+	case 1:
+		return vm.getValue(rt[0], bits[0])
 	}
-	switch ft.ResultTypes[0] {
+	results := make([]interface{}, len(rt))
+	for i, vt := range rt {
+		results[i] = vm.getValue(vt, bits[i])
+	}
+	return results
+}
+
+// returns the top n operands as Go values, without popping them
+func (vm *vm) peekValues(types []binary.ValType) []interface{} {
+	vals := make([]interface{}, len(types))
+	base := len(vm.operandStack.data) - len(types)
+	for i, vt := range types {
+		vals[i] = vm.getValue(vt, vm.operandStack.data[base+i])
+	}
+	return vals
+}
+
+func (vm *vm) getValue(vt binary.ValType, bits uint64) interface{} {
+	switch vt {
 	case binary.ValTypeI32:
-		return vm.popS32()
+		return int32(uint32(bits))
 	case binary.ValTypeI64:
-		return vm.popS64()
+		return int64(bits)
 	case binary.ValTypeF32:
-		return vm.popF32()
+		return math.Float32frombits(uint32(bits))
 	case binary.ValTypeF64:
-		return vm.popF64()
+		return math.Float64frombits(bits)
 	default:
-		panic("unreachable")
+		return vm.getRef(bits)
 	}
 }
 
@@ -400,6 +541,9 @@ func (vm vm) GetGlobalValue(name string) (interface{}, error) {
 			case binary.ValTypeF64:
 				return math.Float64frombits(g.Get()), nil
 			default:
+				if rg, ok := g.(instance.RefGlobal); ok {
+					return rg.GetRef(), nil
+				}
 				panic("unreachable")
 			}
 		}
@@ -435,6 +579,7 @@ func isGlobalTypeMatch(expected, actual binary.GlobalType) bool {
 		actual.Mut == expected.Mut
 }
 func isLimitsMatch(expected, actual binary.Limits) bool {
-	return actual.Min >= expected.Min &&
+	return actual.Is64() == expected.Is64() &&
+		actual.Min >= expected.Min &&
 		(expected.Max == 0 || actual.Max > 0 && actual.Max <= expected.Max)
 }
@@ -9,25 +9,28 @@ var _ instance.Function = (*vmFunc)(nil)
 
 type vmFunc struct {
 	vm       *vm
+	idx      int
 	_type    binary.FuncType
 	code     binary.Code
 	imported instance.Function
 }
 
-func newExternalFunc(vm *vm, ft binary.FuncType,
+func newExternalFunc(vm *vm, idx int, ft binary.FuncType,
 	f instance.Function) vmFunc {
 
 	return vmFunc{
 		vm:       vm,
+		idx:      idx,
 		_type:    ft,
 		imported: f,
 	}
 }
-func newInternalFunc(vm *vm, ft binary.FuncType,
+func newInternalFunc(vm *vm, idx int, ft binary.FuncType,
 	code binary.Code) vmFunc {
 
 	return vmFunc{
 		vm:    vm,
+		idx:   idx,
 		_type: ft,
 		code:  code,
 	}
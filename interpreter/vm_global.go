@@ -6,6 +6,7 @@ import (
 )
 
 var _ instance.Global = (*globalVar)(nil)
+var _ instance.RefGlobal = (*refGlobalVar)(nil)
 
 type globalVar struct {
 	_type binary.GlobalType
@@ -37,3 +38,30 @@ func (g *globalVar) Set(val uint64) {
 	}
 	g.val = val
 }
+
+type refGlobalVar struct {
+	globalVar
+	ref interface{}
+}
+
+func NewRefGlobal(vt binary.ValType, mut bool, ref interface{}) instance.RefGlobal {
+	gt := binary.GlobalType{ValType: vt}
+	if mut {
+		gt.Mut = 1
+	}
+	return newRefGlobal(gt, ref)
+}
+
+func newRefGlobal(gt binary.GlobalType, ref interface{}) *refGlobalVar {
+	return &refGlobalVar{globalVar: globalVar{_type: gt}, ref: ref}
+}
+
+func (g *refGlobalVar) GetRef() interface{} {
+	return g.ref
+}
+func (g *refGlobalVar) SetRef(ref interface{}) {
+	if g._type.Mut != 1 {
+		panic("constant global!")
+	}
+	g.ref = ref
+}
@@ -1,19 +1,32 @@
 package interpreter
 
 import (
+	"math"
+
 	"github.com/zxh0/wasm.go/binary"
 	"github.com/zxh0/wasm.go/instance"
 )
 
 var _ instance.Memory = (*memory)(nil)
 
+const growFailed = math.MaxUint64 // -1
+
+// 64-bit memories are still backed by a single []byte,
+// so their page count is capped by the host (64GiB).
+const maxMem64PageCount = 1 << 20
+
 type memory struct {
 	_type binary.MemType
 	data  []byte
 }
 
 func NewMemory(min, max uint32) instance.Memory {
-	mt := binary.MemType{Min: min, Max: max}
+	mt := binary.MemType{Min: uint64(min), Max: uint64(max)}
+	return newMemory(mt)
+}
+
+func NewMemory64(min, max uint64) instance.Memory {
+	mt := binary.MemType{Tag: binary.LimitsFlag64, Min: min, Max: max}
 	return newMemory(mt)
 }
 
@@ -28,21 +41,24 @@ func (mem *memory) Type() binary.MemType {
 	return mem._type
 }
 
-func (mem *memory) Size() uint32 {
-	return uint32(len(mem.data) / binary.PageSize)
+func (mem *memory) Size() uint64 {
+	return uint64(len(mem.data) / binary.PageSize)
 }
-func (mem *memory) Grow(n uint32) uint32 {
+func (mem *memory) Grow(n uint64) uint64 {
 	curPageCount := mem.Size()
 	if n == 0 {
 		return curPageCount
 	}
 
-	maxPageCount := uint32(binary.MaxPageCount)
-	if max := mem._type.Max; max > 0 {
+	maxPageCount := uint64(binary.MaxPageCount)
+	if mem._type.Is64() {
+		maxPageCount = maxMem64PageCount
+	}
+	if max := mem._type.Max; max > 0 && max < maxPageCount {
 		maxPageCount = max
 	}
-	if curPageCount+n > maxPageCount {
-		return 0xFFFFFFFF // -1
+	if n > maxPageCount-curPageCount {
+		return growFailed
 	}
 
 	newData := make([]byte, (curPageCount+n)*binary.PageSize)
@@ -61,7 +77,8 @@ func (mem *memory) Write(offset uint64, data []byte) {
 }
 
 func (mem *memory) checkOffset(offset uint64, length int) {
-	if int64(len(mem.data)-length) < int64(offset) {
-		panic("out of bounds memory access")
+	size := uint64(len(mem.data))
+	if offset > size || size-offset < uint64(length) {
+		panic(errOutOfBoundsMemory)
 	}
 }
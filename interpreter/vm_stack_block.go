@@ -14,6 +14,7 @@ type blockFrame struct {
 	bt     byte             // block type
 	bp     int              // operand stack base pointer
 	pc     int              // program counter
+	fIdx   int              // func index, for btFunc
 }
 
 type blockStack struct {
@@ -26,6 +26,16 @@ func (s *operandStack) popU64() uint64 {
 	return val
 }
 
+func (s *operandStack) pushU64s(vals []uint64) {
+	s.data = append(s.data, vals...)
+}
+func (s *operandStack) popU64s(n int) []uint64 {
+	vals := make([]uint64, n)
+	copy(vals, s.data[len(s.data)-n:])
+	s.data = s.data[:len(s.data)-n]
+	return vals
+}
+
 func (s *operandStack) pushS64(val int64) {
 	s.pushU64(uint64(val))
 }
@@ -1,10 +1,14 @@
 package interpreter
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 	"github.com/zxh0/wasm.go/binary"
+	"github.com/zxh0/wasm.go/instance"
+	"github.com/zxh0/wasm.go/text"
+	"github.com/zxh0/wasm.go/validator"
 )
 
 func TestOperandStack(t *testing.T) {
@@ -72,9 +76,9 @@ func TestMem(t *testing.T) {
 	mem.Read(11, buf)
 	require.Equal(t, []byte{0x02, 0x03, 0x00}, buf)
 
-	require.Equal(t, uint32(1), mem.Size())
-	require.Equal(t, uint32(1), mem.Grow(3))
-	require.Equal(t, uint32(4), mem.Size())
+	require.Equal(t, uint64(1), mem.Size())
+	require.Equal(t, uint64(1), mem.Grow(3))
+	require.Equal(t, uint64(4), mem.Size())
 }
 
 func TestGlobalVar(t *testing.T) {
@@ -85,3 +89,196 @@ func TestGlobalVar(t *testing.T) {
 	g.Set(100)
 	require.Equal(t, uint64(100), g.Get())
 }
+
+func TestExtendedConstExpr(t *testing.T) {
+	i32 := binary.GlobalType{ValType: binary.ValTypeI32}
+	i64 := binary.GlobalType{ValType: binary.ValTypeI64}
+	m := binary.Module{
+		GlobalSec: []binary.Global{
+			{Type: i32, Expr: []binary.Instruction{
+				{Opcode: binary.I32Const, Args: int32(10)},
+			}},
+			{Type: i32, Expr: []binary.Instruction{
+				{Opcode: binary.GlobalGet, Args: uint32(0)},
+				{Opcode: binary.I32Const, Args: int32(3)},
+				{Opcode: binary.I32Mul},
+				{Opcode: binary.I32Const, Args: int32(1)},
+				{Opcode: binary.I32Sub},
+			}},
+			{Type: i64, Expr: []binary.Instruction{
+				{Opcode: binary.I64Const, Args: int64(-1)},
+				{Opcode: binary.I64Const, Args: int64(2)},
+				{Opcode: binary.I64Add},
+			}},
+		},
+		ExportSec: []binary.Export{
+			{Name: "a", Desc: binary.ExportDesc{Tag: binary.ExportTagGlobal, Idx: 1}},
+			{Name: "b", Desc: binary.ExportDesc{Tag: binary.ExportTagGlobal, Idx: 2}},
+		},
+	}
+
+	err, _ := validator.ValidateWithOptions(m, validator.Options{})
+	require.EqualError(t, err,
+		"global[1]: i32.mul in constant expression requires feature extended-const")
+
+	inst, err := NewInstance(m, nil)
+	require.NoError(t, err)
+	a, err := inst.GetGlobalValue("a")
+	require.NoError(t, err)
+	require.Equal(t, int32(29), a)
+	b, err := inst.GetGlobalValue("b")
+	require.NoError(t, err)
+	require.Equal(t, int64(1), b)
+}
+
+func TestTrapLocation(t *testing.T) {
+	m := binary.Module{
+		TypeSec: []binary.FuncType{{}},
+		FuncSec: []binary.TypeIdx{0, 0},
+		CodeSec: []binary.Code{
+			{Offset: 0x20, Expr: []binary.Instruction{
+				{Opcode: binary.Nop, Offset: 0x21},
+				{Opcode: binary.Call, Args: uint32(1), Offset: 0x22},
+			}},
+			{Offset: 0x30, Expr: []binary.Instruction{
+				{Opcode: binary.Block, Offset: 0x31, Args: binary.BlockArgs{
+					Instrs: []binary.Instruction{
+						{Opcode: binary.Nop, Offset: 0x33},
+						{Opcode: binary.Unreachable, Offset: 0x34},
+					},
+				}},
+			}},
+		},
+		ExportSec: []binary.Export{
+			{Name: "f", Desc: binary.ExportDesc{Tag: binary.ExportTagFunc, Idx: 0}},
+		},
+	}
+
+	inst, err := NewInstance(m, nil)
+	require.NoError(t, err)
+	_, err = inst.CallFunc("f")
+	require.EqualError(t, err, "func[1]+0x4: unreachable")
+	trap, ok := err.(*Trap)
+	require.True(t, ok)
+	require.Equal(t, "func[1]+0x4", trap.Location)
+}
+
+func TestTrapValues(t *testing.T) {
+	m, err := text.CompileModuleStr(`(module
+  (import "env" "host" (func $host))
+  (func (export "div") (param i32 i32) (result i32)
+    (i32.div_u (local.get 0) (local.get 1)))
+  (func (export "rem") (param i64 i64) (result i64)
+    (i64.rem_s (local.get 0) (local.get 1)))
+  (func (export "host") (call $host)))`)
+	require.NoError(t, err)
+
+	var hostPanic interface{}
+	env := instance.NewNativeInstance()
+	env.RegisterFunc("host", func(args ...interface{}) (interface{}, error) {
+		if hostPanic != nil {
+			panic(hostPanic)
+		}
+		return nil, errors.New("host failure")
+	}, binary.NoVal)
+	inst, err := NewInstance(*m, instance.Map{"env": env})
+	require.NoError(t, err)
+
+	_, err = inst.CallFunc("div", int32(1), int32(0))
+	require.Equal(t, "integer divide by zero", err.(*Trap).Msg)
+	_, err = inst.CallFunc("rem", int64(1), int64(0))
+	require.Equal(t, "integer divide by zero", err.(*Trap).Msg)
+	_, err = inst.CallFunc("div", int32(1), 0)
+	require.Equal(t, "arg[1]: int is not i32", err.(*Trap).Msg)
+	_, err = inst.CallFunc("host")
+	require.Equal(t, "host failure", err.(*Trap).Msg)
+
+	hostPanic = "bug"
+	require.PanicsWithValue(t, "bug", func() {
+		_, _ = inst.CallFunc("host")
+	})
+}
+
+func TestTrapBacktrace(t *testing.T) {
+	m, err := binary.DecodeFile("../binary/dwarf/testdata/lib.wasm")
+	require.NoError(t, err)
+
+	env := instance.NewNativeInstance()
+	env.RegisterFunc("check", func(args ...interface{}) (interface{}, error) {
+		return nil, errors.New("bad header")
+	}, binary.ValTypeI32, binary.ValTypeI32)
+	inst, err := NewInstance(m, instance.Map{"env": env})
+	require.NoError(t, err)
+
+	_, err = inst.CallFunc("run", int32(1))
+	require.Error(t, err)
+	trap := err.(*Trap)
+	require.Equal(t, "bad header", trap.Msg)
+	require.Equal(t, []string{
+		"src/lib.rs:22 in parse_header",
+		"src/lib.rs:28 in run",
+	}, trap.Backtrace)
+	require.Contains(t, err.Error(), "\n\tat src/lib.rs:22 in parse_header")
+}
+
+func TestBuilderModule(t *testing.T) {
+	i32 := binary.ValTypeI32
+	b := binary.NewBuilder()
+	double := b.AddFunc(binary.FuncType{
+		ParamTypes:  []binary.ValType{i32},
+		ResultTypes: []binary.ValType{i32},
+	}, []binary.ValType{i32}, binary.Expr{
+		{Opcode: binary.LocalGet, Args: uint32(0)},
+		{Opcode: binary.LocalTee, Args: uint32(1)},
+		{Opcode: binary.LocalGet, Args: uint32(1)},
+		{Opcode: binary.I32Add},
+	})
+	b.ExportFunc("double", double)
+	m := b.Module()
+
+	err, _ := validator.Validate(m)
+	require.NoError(t, err)
+	inst, err := NewInstance(m, nil)
+	require.NoError(t, err)
+	result, err := inst.CallFunc("double", int32(21))
+	require.NoError(t, err)
+	require.Equal(t, int32(42), result)
+}
+
+func TestMultiValue(t *testing.T) {
+	m, err := text.CompileModuleStr(`(module
+  (import "env" "swap" (func $swap (param i32 i64) (result i64 i32)))
+  (func (export "swap") (param i32 i64) (result i64 i32)
+    i32.const 9
+    local.get 0
+    local.get 1
+    call $swap
+    return)
+  (func (export "id") (param externref) (result externref i32)
+    local.get 0
+    local.get 0
+    ref.is_null))`)
+	require.NoError(t, err)
+
+	env := instance.NewNativeInstance()
+	env.RegisterFuncOfType("swap", func(args ...interface{}) (interface{}, error) {
+		return []interface{}{args[1], args[0]}, nil
+	}, binary.FuncType{
+		ParamTypes:  []binary.ValType{binary.ValTypeI32, binary.ValTypeI64},
+		ResultTypes: []binary.ValType{binary.ValTypeI64, binary.ValTypeI32},
+	})
+	inst, err := NewInstance(*m, instance.Map{"env": env})
+	require.NoError(t, err)
+
+	results, err := inst.CallFunc("swap", int32(1), int64(2))
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{int64(2), int32(1)}, results)
+
+	type extern struct{ x int }
+	results, err = inst.CallFunc("id", &extern{1})
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{&extern{1}, int32(0)}, results)
+	results, err = inst.CallFunc("id", nil)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{nil, int32(1)}, results)
+}
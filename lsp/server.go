@@ -0,0 +1,372 @@
+// Package lsp implements a language server for .wat and .wast files.
+package lsp
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+
+	"github.com/zxh0/wasm.go/binary"
+	"github.com/zxh0/wasm.go/text"
+)
+
+type server struct {
+	reader *bufio.Reader
+	writer io.Writer
+	docs   map[string]*document // uri -> open document
+}
+
+type document struct {
+	uri      string
+	lines    []string
+	analysis *text.Analysis
+}
+
+// Serve handles the messages read from r until the exit notification
+// or the end of r, the responses are written to w.
+func Serve(r io.Reader, w io.Writer) error {
+	s := &server{
+		reader: bufio.NewReader(r),
+		writer: w,
+		docs:   map[string]*document{},
+	}
+	for {
+		data, err := s.read()
+		if err == io.EOF {
+			return nil
+		} else if err != nil {
+			return err
+		}
+
+		var msg message
+		if err := json.Unmarshal(data, &msg); err != nil {
+			if err := s.replyErr(nil, codeParseError, err.Error()); err != nil {
+				return err
+			}
+			continue
+		}
+		if msg.Method == "exit" {
+			return nil
+		}
+		if err := s.handle(msg); err != nil {
+			return err
+		}
+	}
+}
+
+/* base protocol */
+
+// Content-Length: n\r\n\r\n{...}
+func (s *server) read() ([]byte, error) {
+	n := -1
+	for {
+		line, err := s.reader.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		line = strings.TrimSpace(line)
+		if line == "" {
+			break
+		}
+		if i := strings.IndexByte(line, ':'); i > 0 &&
+			strings.EqualFold(line[:i], "Content-Length") {
+
+			if n, err = strconv.Atoi(strings.TrimSpace(line[i+1:])); err != nil {
+				return nil, fmt.Errorf("invalid header: %s", line)
+			}
+		}
+	}
+	if n < 0 {
+		return nil, fmt.Errorf("missing Content-Length header")
+	}
+	data := make([]byte, n)
+	_, err := io.ReadFull(s.reader, data)
+	return data, err
+}
+
+func (s *server) write(v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(s.writer, "Content-Length: %d\r\n\r\n%s", len(data), data)
+	return err
+}
+
+func (s *server) reply(id *json.RawMessage, result interface{}) error {
+	return s.write(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      id,
+		"result":  result,
+	})
+}
+
+func (s *server) replyErr(id *json.RawMessage, code int, msg string) error {
+	return s.write(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      id,
+		"error":   responseError{Code: code, Message: msg},
+	})
+}
+
+func (s *server) notify(method string, params interface{}) error {
+	return s.write(map[string]interface{}{
+		"jsonrpc": "2.0",
+		"method":  method,
+		"params":  params,
+	})
+}
+
+/* methods */
+
+func (s *server) handle(msg message) error {
+	var result interface{}
+	var err error
+	switch msg.Method {
+	case "initialize":
+		result = s.initialize()
+	case "shutdown":
+		result = nil
+	case "textDocument/didOpen":
+		var params didOpenTextDocumentParams
+		if err = json.Unmarshal(msg.Params, &params); err == nil {
+			return s.update(params.TextDocument.URI, params.TextDocument.Text)
+		}
+	case "textDocument/didChange":
+		var params didChangeTextDocumentParams
+		if err = json.Unmarshal(msg.Params, &params); err == nil &&
+			len(params.ContentChanges) > 0 {
+
+			changes := params.ContentChanges
+			return s.update(params.TextDocument.URI, changes[len(changes)-1].Text)
+		}
+	case "textDocument/didClose":
+		var params didCloseTextDocumentParams
+		if err = json.Unmarshal(msg.Params, &params); err == nil {
+			delete(s.docs, params.TextDocument.URI)
+			return s.notify("textDocument/publishDiagnostics", publishDiagnosticsParams{
+				URI:         params.TextDocument.URI,
+				Diagnostics: []diagnostic{},
+			})
+		}
+	case "textDocument/definition":
+		var params textDocumentPositionParams
+		if err = json.Unmarshal(msg.Params, &params); err == nil {
+			result = s.definition(params)
+		}
+	case "textDocument/references":
+		var params referenceParams
+		if err = json.Unmarshal(msg.Params, &params); err == nil {
+			result = s.references(params)
+		}
+	case "textDocument/hover":
+		var params textDocumentPositionParams
+		if err = json.Unmarshal(msg.Params, &params); err == nil {
+			result = s.hover(params)
+		}
+	case "textDocument/completion":
+		var params textDocumentPositionParams
+		if err = json.Unmarshal(msg.Params, &params); err == nil {
+			result = s.completion(params)
+		}
+	default:
+		if msg.ID == nil { // notifications are ignored
+			return nil
+		}
+		return s.replyErr(msg.ID, codeMethodNotFound, "method not found: "+msg.Method)
+	}
+
+	if msg.ID == nil {
+		return nil
+	}
+	if err != nil {
+		return s.replyErr(msg.ID, codeInvalidParams, err.Error())
+	}
+	return s.reply(msg.ID, result)
+}
+
+func (s *server) initialize() initializeResult {
+	var result initializeResult
+	result.Capabilities.TextDocumentSync = textDocumentSyncFull
+	result.Capabilities.DefinitionProvider = true
+	result.Capabilities.ReferencesProvider = true
+	result.Capabilities.HoverProvider = true
+	result.Capabilities.CompletionProvider.TriggerCharacters = []string{"."}
+	result.ServerInfo.Name = "wasmgo"
+	return result
+}
+
+// analyzes the document and publishes its diagnostics
+func (s *server) update(uri, src string) error {
+	doc := &document{
+		uri:      uri,
+		lines:    strings.Split(src, "\n"),
+		analysis: text.Analyze(getFilename(uri), src),
+	}
+	s.docs[uri] = doc
+
+	diags := make([]diagnostic, 0, len(doc.analysis.Diagnostics))
+	for _, d := range doc.analysis.Diagnostics {
+		diags = append(diags, diagnostic{
+			Range:    doc.getRange(d.Line, d.Column, getUnderlineLen(d.Snippet)),
+			Severity: severityError,
+			Source:   "wasmgo",
+			Message:  d.Msg,
+		})
+	}
+	return s.notify("textDocument/publishDiagnostics", publishDiagnosticsParams{
+		URI:         uri,
+		Diagnostics: diags,
+	})
+}
+
+// returns the document and the symbol at the position, or nil
+func (s *server) getSymbol(params textDocumentPositionParams) (*document, *text.Symbol) {
+	doc := s.docs[params.TextDocument.URI]
+	if doc == nil {
+		return nil, nil
+	}
+	line, column := doc.getLineColumn(params.Position)
+	return doc, doc.analysis.GetSymbol(line, column)
+}
+
+func (s *server) definition(params textDocumentPositionParams) interface{} {
+	doc, sym := s.getSymbol(params)
+	if sym == nil || sym.Def == nil {
+		return nil
+	}
+	return doc.getLocation(sym.Def)
+}
+
+func (s *server) references(params referenceParams) []location {
+	locations := []location{}
+	doc, sym := s.getSymbol(params.textDocumentPositionParams)
+	if sym == nil || sym.Def == nil {
+		return locations
+	}
+	if params.Context.IncludeDeclaration {
+		locations = append(locations, doc.getLocation(sym.Def))
+	}
+	for _, ref := range doc.analysis.GetReferences(sym.Def) {
+		locations = append(locations, doc.getLocation(ref))
+	}
+	return locations
+}
+
+func (s *server) hover(params textDocumentPositionParams) interface{} {
+	doc, sym := s.getSymbol(params)
+	if sym == nil || sym.Def == nil || sym.Def.Detail == "" {
+		return nil
+	}
+	return hover{
+		Contents: markupContent{
+			Kind:  "markdown",
+			Value: "```wat\n" + sym.Def.Detail + "\n```",
+		},
+		Range: doc.getSymbolRange(sym),
+	}
+}
+
+// instruction names starting with the word before the position
+func (s *server) completion(params textDocumentPositionParams) completionList {
+	list := completionList{Items: []completionItem{}}
+	doc := s.docs[params.TextDocument.URI]
+	if doc == nil || params.Position.Line >= len(doc.lines) {
+		return list
+	}
+	line := doc.lines[params.Position.Line]
+	end := getByteOffset(line, params.Position.Character)
+	start := end
+	for start > 0 && isIdChar(line[start-1]) {
+		start--
+	}
+	prefix := line[start:end]
+	if strings.HasPrefix(prefix, "$") {
+		return list
+	}
+	for _, name := range binary.Opnames() {
+		if strings.HasPrefix(name, prefix) {
+			opcode, _ := binary.GetOpcode(name)
+			list.Items = append(list.Items, completionItem{
+				Label:  name,
+				Kind:   completionItemKindKeyword,
+				Detail: fmt.Sprintf("opcode 0x%02x", opcode),
+			})
+		}
+	}
+	return list
+}
+
+/* positions */
+
+// text.Symbol and text.Diagnostic have 1-based lines and columns in bytes
+func (doc *document) getLineColumn(pos position) (int, int) {
+	if pos.Line >= len(doc.lines) {
+		return pos.Line + 1, 1
+	}
+	return pos.Line + 1, getByteOffset(doc.lines[pos.Line], pos.Character) + 1
+}
+
+func (doc *document) getPosition(line, column int) position {
+	if line < 1 || line > len(doc.lines) {
+		return position{}
+	}
+	s := doc.lines[line-1]
+	if column-1 < len(s) {
+		s = s[:column-1]
+	}
+	return position{Line: line - 1, Character: len(utf16.Encode([]rune(s)))}
+}
+
+func (doc *document) getRange(line, column, n int) textRange {
+	return textRange{
+		Start: doc.getPosition(line, column),
+		End:   doc.getPosition(line, column+n),
+	}
+}
+
+func (doc *document) getSymbolRange(sym *text.Symbol) textRange {
+	return doc.getRange(sym.Line, sym.Column, len(sym.Name))
+}
+
+func (doc *document) getLocation(sym *text.Symbol) location {
+	return location{URI: doc.uri, Range: doc.getSymbolRange(sym)}
+}
+
+// converts a UTF-16 offset
+func getByteOffset(line string, character int) int {
+	offset := 0
+	for character > 0 && offset < len(line) {
+		r, n := utf8.DecodeRuneInString(line[offset:])
+		offset += n
+		character -= utf16.RuneLen(r)
+	}
+	return offset
+}
+
+// the number of ^ under the source line of a diagnostic
+func getUnderlineLen(snippet string) int {
+	if n := strings.Count(snippet[strings.LastIndexByte(snippet, '\n')+1:], "^"); n > 0 {
+		return n
+	}
+	return 1
+}
+
+// file:///dir/x.wat -> /dir/x.wat
+func getFilename(uri string) string {
+	if u, err := url.Parse(uri); err == nil && u.Scheme == "file" {
+		return u.Path
+	}
+	return uri
+}
+
+func isIdChar(c byte) bool {
+	return c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' ||
+		strings.IndexByte("!#$%&'*+-./:<=>?@\\^_`|~", c) >= 0
+}
@@ -0,0 +1,130 @@
+package lsp
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testURI = "file:///tmp/x.wat"
+
+const testWAT = `(module
+  (func $f (param $x i32) (result i32)
+    (local.get $x))
+  (func (result i32)
+    (call $f (i32.const 1))))`
+
+func TestServe(t *testing.T) {
+	msgs := serve(t,
+		request(1, "initialize", map[string]interface{}{}),
+		notification("initialized", map[string]interface{}{}),
+		notification("textDocument/didOpen", map[string]interface{}{
+			"textDocument": map[string]interface{}{"uri": testURI, "text": testWAT},
+		}),
+		request(2, "textDocument/definition", positionParams(4, 10)),
+		request(3, "textDocument/references", map[string]interface{}{
+			"textDocument": map[string]string{"uri": testURI},
+			"position":     map[string]int{"line": 1, "character": 9},
+			"context":      map[string]bool{"includeDeclaration": true},
+		}),
+		request(4, "textDocument/hover", positionParams(4, 11)),
+		request(5, "textDocument/completion", positionParams(2, 12)),
+		notification("textDocument/didChange", map[string]interface{}{
+			"textDocument":   map[string]string{"uri": testURI},
+			"contentChanges": []map[string]string{{"text": "(module\n  (func (call $g)))"}},
+		}),
+		request(6, "foo", nil),
+		request(7, "shutdown", nil),
+		notification("exit", nil),
+		request(8, "shutdown", nil), // ignored
+	)
+	require.Len(t, msgs, 9)
+
+	require.Equal(t, true, get(msgs[0], "result", "capabilities", "hoverProvider"))
+	require.Equal(t, "textDocument/publishDiagnostics", msgs[1]["method"])
+	require.Empty(t, get(msgs[1], "params", "diagnostics"))
+
+	require.Equal(t, map[string]interface{}{
+		"uri": testURI,
+		"range": map[string]interface{}{
+			"start": map[string]interface{}{"line": 1.0, "character": 8.0},
+			"end":   map[string]interface{}{"line": 1.0, "character": 10.0},
+		},
+	}, get(msgs[2], "result"))
+	require.Len(t, get(msgs[3], "result"), 2)
+	require.Equal(t, "```wat\n(func $f (param i32) (result i32))\n```",
+		get(msgs[4], "result", "contents", "value"))
+
+	items := get(msgs[5], "result", "items").([]interface{})
+	require.NotEmpty(t, items)
+	for _, item := range items {
+		require.Regexp(t, "^local\\.", item.(map[string]interface{})["label"])
+	}
+
+	diags := get(msgs[6], "params", "diagnostics").([]interface{})
+	require.Len(t, diags, 1)
+	require.Equal(t, map[string]interface{}{
+		"start": map[string]interface{}{"line": 1.0, "character": 14.0},
+		"end":   map[string]interface{}{"line": 1.0, "character": 16.0},
+	}, get(diags[0], "range"))
+
+	require.Equal(t, -32601.0, get(msgs[7], "error", "code"))
+	require.Contains(t, msgs[8], "result")
+	require.Nil(t, msgs[8]["result"])
+}
+
+func serve(t *testing.T, msgs ...string) []map[string]interface{} {
+	in := &bytes.Buffer{}
+	for _, msg := range msgs {
+		fmt.Fprintf(in, "Content-Length: %d\r\n\r\n%s", len(msg), msg)
+	}
+	out := &bytes.Buffer{}
+	require.NoError(t, Serve(in, out))
+
+	s := &server{reader: bufio.NewReader(out)}
+	var results []map[string]interface{}
+	for {
+		data, err := s.read()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		var result map[string]interface{}
+		require.NoError(t, json.Unmarshal(data, &result))
+		results = append(results, result)
+	}
+	return results
+}
+
+func request(id int, method string, params interface{}) string {
+	data, _ := json.Marshal(map[string]interface{}{
+		"jsonrpc": "2.0", "id": id, "method": method, "params": params,
+	})
+	return string(data)
+}
+
+func notification(method string, params interface{}) string {
+	data, _ := json.Marshal(map[string]interface{}{
+		"jsonrpc": "2.0", "method": method, "params": params,
+	})
+	return string(data)
+}
+
+func positionParams(line, character int) map[string]interface{} {
+	return map[string]interface{}{
+		"textDocument": map[string]string{"uri": testURI},
+		"position":     map[string]int{"line": line, "character": character},
+	}
+}
+
+func get(v interface{}, keys ...string) interface{} {
+	for _, key := range keys {
+		v = v.(map[string]interface{})[key]
+	}
+	return v
+}
@@ -1,6 +0,0 @@
-#!/usr/bin/env bash
-
-G4=./text/grammar/WAST.g4
-OUT=./text/parser
-rm ${OUT}/*
-antlr -Dlanguage=Go -Xexact-output-dir -visitor -no-listener -o ${OUT} ${G4}
\ No newline at end of file
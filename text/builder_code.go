@@ -7,6 +7,7 @@ import (
 type codeBuilder struct {
 	locals     []binary.Locals
 	localNames *symbolTable
+	nameAnns   binary.NameMap // local_idx -> (@name) annotation
 	labelNames *symbolTable
 	blockDepth int
 }
@@ -14,6 +15,7 @@ type codeBuilder struct {
 func newCodeBuilder() *codeBuilder {
 	return &codeBuilder{
 		localNames: newSymbolTable("parameter"),
+		nameAnns:   binary.NameMap{},
 		labelNames: newSymbolTable("label"),
 	}
 }
@@ -44,6 +46,11 @@ func (b *codeBuilder) addLocal(name string, t binary.ValType) error {
 	return nil
 }
 
+// annotates the last param or local
+func (b *codeBuilder) setNameAnn(name string) {
+	b.nameAnns[uint32(b.localNames.defined-1)] = name
+}
+
 /* labels */
 
 func (b *codeBuilder) enterBlock() {
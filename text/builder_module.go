@@ -1,30 +1,45 @@
 package text
 
 import (
+	"strings"
+
 	"github.com/zxh0/wasm.go/binary"
 )
 
 type moduleBuilder struct {
-	round    int
-	module   *binary.Module
-	ftyBySig map[string]int // sig  -> ftyIdx
-	ftyNames *symbolTable   // name -> ftyIdx
-	funNames *symbolTable   // name -> funIdx
-	tabNames *symbolTable   // name -> tabIdx
-	memNames *symbolTable   // name -> memIdx
-	glbNames *symbolTable   // name -> glbIdx
+	builder  *binary.Builder      // types, index spaces and sections
+	ftyNames *symbolTable         // name -> ftyIdx
+	funNames *symbolTable         // name -> funIdx
+	tabNames *symbolTable         // name -> tabIdx
+	memNames *symbolTable         // name -> memIdx
+	glbNames *symbolTable         // name -> glbIdx
+	locNames map[int]*symbolTable // funIdx -> params & locals
+	nameAnns *binary.NameSec      // from (@name) annotations, nil if none
 }
 
 func newModuleBuilder() *moduleBuilder {
 	return &moduleBuilder{
-		module:   &binary.Module{},
-		ftyBySig: map[string]int{},
+		builder:  binary.NewBuilder(),
 		ftyNames: newSymbolTable("function type"),
 		funNames: newSymbolTable("function"),
 		tabNames: newSymbolTable("table"),
 		memNames: newSymbolTable("memory"),
 		glbNames: newSymbolTable("global"),
+		locNames: map[int]*symbolTable{},
+	}
+}
+
+func (b *moduleBuilder) getModule() *binary.Module {
+	m := b.builder.Module()
+	return &m
+}
+
+// zero value if idx is out of range
+func (b *moduleBuilder) getFuncType(idx int) binary.FuncType {
+	if types := b.builder.Module().TypeSec; idx >= 0 && idx < len(types) {
+		return types[idx]
 	}
+	return binary.FuncType{}
 }
 
 func (b *moduleBuilder) getFuncTypeIdx(_var string) (int, error) {
@@ -44,7 +59,7 @@ func (b *moduleBuilder) getGlobalIdx(_var string) (int, error) {
 }
 
 func (b *moduleBuilder) ensureNoStart() error {
-	if b.module.StartSec != nil {
+	if b.builder.Module().StartSec != nil {
 		return newVerificationError("multiple start sections")
 	}
 	return nil
@@ -105,55 +120,109 @@ func (b *moduleBuilder) addTypeDef(name string, ft binary.FuncType) error {
 		return err
 	}
 
-	b.module.TypeSec = append(b.module.TypeSec, ft)
-	sig := ft.GetSignature()
-	if _, found := b.ftyBySig[sig]; !found {
-		b.ftyBySig[sig] = len(b.module.TypeSec) - 1
-	}
+	b.builder.DefineType(ft)
 	return nil
 }
 func (b *moduleBuilder) addTypeUse(ft binary.FuncType) int {
-	sig := ft.GetSignature()
-	if idx, found := b.ftyBySig[sig]; found {
-		return idx
+	n := len(b.builder.Module().TypeSec)
+	idx := int(b.builder.AddType(ft))
+	if idx == n { // new type
+		_ = b.ftyNames.defineName("")
 	}
-
-	b.module.TypeSec = append(b.module.TypeSec, ft)
-	idx := len(b.module.TypeSec) - 1
-	b.ftyBySig[sig] = idx
-	_ = b.ftyNames.defineName("")
 	return idx
 }
 
-func (b *moduleBuilder) addImport(imp binary.Import) int {
-	b.module.ImportSec = append(b.module.ImportSec, imp)
-	return b.calcImportedCount(imp.Desc.Tag) - 1
-}
-func (b *moduleBuilder) calcImportedCount(tag byte) int {
-	n := 0
-	for _, imp := range b.module.ImportSec {
-		if imp.Desc.Tag == tag {
-			n++
-		}
+func (b *moduleBuilder) addImport(imp binary.Import) (int, error) {
+	idx, err := b.builder.AddImport(imp)
+	if err != nil {
+		return 0, newSemanticError("%s", err)
 	}
-	return n
+	return int(idx), nil
 }
 
 func (b *moduleBuilder) addFunc(ftIdx int,
 	locals []binary.Locals, expr []binary.Instruction) int {
 
-	b.module.FuncSec = append(b.module.FuncSec, uint32(ftIdx))
-	b.module.CodeSec = append(b.module.CodeSec, binary.Code{
-		Locals: locals,
-		Expr:   expr,
-	})
+	return int(b.builder.AddFuncOfType(uint32(ftIdx), locals, expr))
+}
+
+func (b *moduleBuilder) setLocalNames(funIdx int, names *symbolTable) {
+	b.locNames[funIdx] = names
+}
+
+// builds the "name" custom section from $identifiers if ids is true,
+// (@name) annotations take precedence over $identifiers
+func (b *moduleBuilder) getNameSec(moduleName string, ids bool) binary.NameSec {
+	sec := binary.NameSec{
+		FuncNames:  binary.NameMap{},
+		LocalNames: map[binary.FuncIdx]binary.NameMap{},
+	}
+	if ids {
+		sec.ModuleName = strings.TrimPrefix(moduleName, "$")
+		sec.FuncNames = getNameMap(b.funNames)
+		for funIdx, names := range b.locNames {
+			if m := getNameMap(names); len(m) > 0 {
+				sec.LocalNames[uint32(funIdx)] = m
+			}
+		}
+	}
+	if anns := b.nameAnns; anns != nil {
+		if anns.ModuleName != "" {
+			sec.ModuleName = anns.ModuleName
+		}
+		for idx, name := range anns.FuncNames {
+			sec.FuncNames[idx] = name
+		}
+		for funIdx, m := range anns.LocalNames {
+			if sec.LocalNames[funIdx] == nil {
+				sec.LocalNames[funIdx] = binary.NameMap{}
+			}
+			for idx, name := range m {
+				sec.LocalNames[funIdx][idx] = name
+			}
+		}
+	}
+	return sec
+}
+
+func (b *moduleBuilder) hasNameAnns() bool {
+	return b.nameAnns != nil
+}
+
+func (b *moduleBuilder) getNameAnns() *binary.NameSec {
+	if b.nameAnns == nil {
+		b.nameAnns = &binary.NameSec{
+			FuncNames:  binary.NameMap{},
+			LocalNames: map[binary.FuncIdx]binary.NameMap{},
+		}
+	}
+	return b.nameAnns
+}
+
+func (b *moduleBuilder) setModuleNameAnn(name string) {
+	b.getNameAnns().ModuleName = name
+}
+
+func (b *moduleBuilder) setFuncNameAnn(funIdx int, name string) {
+	b.getNameAnns().FuncNames[uint32(funIdx)] = name
+}
 
-	return b.funNames.imported + len(b.module.FuncSec) - 1
+func (b *moduleBuilder) setLocalNameAnns(funIdx int, names binary.NameMap) {
+	if len(names) > 0 {
+		b.getNameAnns().LocalNames[uint32(funIdx)] = names
+	}
+}
+
+func getNameMap(st *symbolTable) binary.NameMap {
+	m := binary.NameMap{}
+	for name, idx := range st.idxByName {
+		m[uint32(idx)] = strings.TrimPrefix(name, "$")
+	}
+	return m
 }
 
 func (b *moduleBuilder) addTable(tt binary.TableType) error {
-	b.module.TableSec = append(b.module.TableSec, tt)
-	if b.tabNames.imported+len(b.module.TableSec) > 1 {
+	if b.builder.AddTable(tt) > 0 {
 		return newVerificationError("only one table allowed")
 	}
 	return nil
@@ -162,20 +231,15 @@ func (b *moduleBuilder) addTableWithElems(funcIndices []binary.FuncIdx) error {
 	err := b.addTable(binary.TableType{
 		ElemType: binary.FuncRef,
 		Limits: binary.Limits{
-			Min: uint32(len(funcIndices)),
+			Min: uint64(len(funcIndices)),
 		},
 	})
-	b.module.ElemSec = append(b.module.ElemSec, binary.Elem{
-		Table:  0,
-		Offset: []binary.Instruction{newI32Const0()},
-		Init:   funcIndices,
-	})
+	b.builder.AddElem(0, []binary.Instruction{newI32Const0()}, funcIndices)
 	return err
 }
 
 func (b *moduleBuilder) addMemory(mt binary.MemType) error {
-	b.module.MemSec = append(b.module.MemSec, mt)
-	if b.memNames.imported+len(b.module.MemSec) > 1 {
+	if b.builder.AddMem(mt) > 0 {
 		return newVerificationError("only one memory block allowed")
 	}
 	return nil
@@ -184,26 +248,16 @@ func (b *moduleBuilder) addMemory(mt binary.MemType) error {
 func (b *moduleBuilder) addGlobal(gt binary.GlobalType,
 	expr []binary.Instruction) int {
 
-	b.module.GlobalSec = append(b.module.GlobalSec,
-		binary.Global{Type: gt, Expr: expr})
-	return b.glbNames.imported + len(b.module.GlobalSec) - 1
+	return int(b.builder.AddGlobal(gt, expr))
 }
 
 func (b *moduleBuilder) addExport(name string, kind byte, idx int) {
-	b.module.ExportSec = append(b.module.ExportSec,
-		binary.Export{
-			Name: name,
-			Desc: binary.ExportDesc{
-				Tag: kind,
-				Idx: uint32(idx),
-			},
-		})
+	b.builder.AddExport(name, kind, uint32(idx))
 }
 
 func (b *moduleBuilder) addStart(_var string) error {
 	fIdx, err := b.getFuncIdx(_var)
-	idx := uint32(fIdx)
-	b.module.StartSec = &idx
+	b.builder.SetStart(uint32(fIdx))
 	return err
 }
 
@@ -215,26 +269,26 @@ func (b *moduleBuilder) addElem(_var string,
 			return err
 		}
 	}
-	b.module.ElemSec = append(b.module.ElemSec, binary.Elem{
-		Table:  0,
-		Offset: offset,
-		Init:   initData,
-	})
+	b.builder.AddElem(0, offset, initData)
 	return nil
 }
 
+func (b *moduleBuilder) declareElem(initData []binary.FuncIdx) {
+	b.builder.DeclareElem(initData)
+}
+
 func (b *moduleBuilder) addData(_var string,
-	offset []binary.Instruction, initData string) error {
+	offset []binary.Instruction, initData []byte) error {
 
 	if _var != "" {
 		if _, err := b.getMemIdx(_var); err != nil {
 			return err
 		}
 	}
-	b.module.DataSec = append(b.module.DataSec, binary.Data{
-		Mem:    0,
-		Offset: offset,
-		Init:   escape(initData),
-	})
+	b.builder.AddData(0, offset, initData)
 	return nil
 }
+
+func (b *moduleBuilder) addCustomSec(sec binary.CustomSec) {
+	b.builder.AddCustomSec(sec)
+}
@@ -1,89 +1,102 @@
 package text
 
 import (
-	"github.com/antlr/antlr4/runtime/Go/antlr"
+	"io/ioutil"
+
 	"github.com/zxh0/wasm.go/binary"
-	"github.com/zxh0/wasm.go/text/parser"
 )
 
+type CompileOptions struct {
+	Names bool // emit a "name" section built from $identifiers
+}
+
+const strSourceName = "Obtained from string"
+
 // WAT Module
 func CompileModuleFile(filename string) (*binary.Module, error) {
-	input, err := antlr.NewFileStream(filename)
+	return CompileModuleFileWithOptions(filename, CompileOptions{})
+}
+func CompileModuleFileWithOptions(filename string,
+	opts CompileOptions) (*binary.Module, error) {
+
+	src, err := ioutil.ReadFile(filename)
 	if err != nil {
 		return nil, err
 	}
-	return CompileModule(input)
+	return CompileModuleWithOptions(filename, string(src), opts)
 }
 func CompileModuleStr(s string) (*binary.Module, error) {
-	input := antlr.NewInputStream(s)
-	return CompileModule(input)
+	return CompileModuleStrWithOptions(s, CompileOptions{})
 }
-func CompileModule(input antlr.CharStream) (m *binary.Module, err error) {
-	errListener := &ErrorListener{}
-	p := newParser(input, errListener)
-	ctx := p.Module()
-	if err = errListener.GetErrors(input); err != nil {
-		return
-	}
+func CompileModuleStrWithOptions(s string,
+	opts CompileOptions) (*binary.Module, error) {
+
+	return CompileModuleWithOptions(strSourceName, s, opts)
+}
+func CompileModule(sourceName, src string) (*binary.Module, error) {
+	return CompileModuleWithOptions(sourceName, src, CompileOptions{})
+}
+func CompileModuleWithOptions(sourceName, src string,
+	opts CompileOptions) (*binary.Module, error) {
+
+	return compileModule(sourceName, src, opts, nil)
+}
+
+// symbols are recorded if r is not nil
+func compileModule(sourceName, src string, opts CompileOptions,
+	r *symbolRecorder) (m *binary.Module, err error) {
+
+	var p *watParser
 	defer func() {
-		if _err := recover(); _err != nil {
-			err = fillDetail(_err, input)
+		if r := recover(); r != nil {
+			if p == nil { // lexer error
+				p = &watParser{}
+			}
+			p.addErr(r)
+		}
+		if len(p.errs) > 0 {
+			m, err = nil, newDiagnostics(p.errs, sourceName, src)
 		}
 	}()
-	m = ctx.Accept(newWatVisitor()).(*binary.Module)
+	p = newWatParser(tokenize(src), opts)
+	p.symbols = r
+	m = p.parseModule().Module
+	p.expect(tokEOF)
 	return
 }
 
 // WAST Script
 func CompileScriptFile(filename string) (*Script, error) {
-	input, err := antlr.NewFileStream(filename)
+	src, err := ioutil.ReadFile(filename)
 	if err != nil {
 		return nil, err
 	}
-	return CompileScript(input)
+	return CompileScript(filename, string(src))
 }
 func CompileScriptStr(s string) (*Script, error) {
-	input := antlr.NewInputStream(s)
-	return CompileScript(input)
+	return CompileScript(strSourceName, s)
 }
-func CompileScript(input antlr.CharStream) (s *Script, err error) {
-	errListener := &ErrorListener{}
-	p := newParser(input, errListener)
-	ctx := p.Script()
-	if err = errListener.GetErrors(input); err != nil {
-		return
-	}
+func CompileScript(sourceName, src string) (*Script, error) {
+	return compileScript(sourceName, src, nil)
+}
+
+func compileScript(sourceName, src string,
+	r *symbolRecorder) (s *Script, err error) {
+
+	var p *wastParser
 	defer func() {
-		if _err := recover(); _err != nil {
-			err = fillDetail(_err, input)
+		if r := recover(); r != nil {
+			if p == nil { // lexer error
+				p = &wastParser{}
+			}
+			p.addErr(r)
+		}
+		if len(p.errs) > 0 {
+			s, err = nil, newDiagnostics(p.errs, sourceName, src)
 		}
 	}()
-	s = ctx.Accept(newWastVisitor()).(*Script)
+	p = newWastParser(tokenize(src))
+	p.symbols = r
+	s = p.parseScript()
 	return
 }
-
-func newParser(input antlr.CharStream,
-	errListener antlr.ErrorListener) *parser.WASTParser {
-
-	lexer := parser.NewWASTLexer(input)
-	stream := antlr.NewCommonTokenStream(lexer, 0)
-	p := parser.NewWASTParser(stream)
-	p.RemoveErrorListeners()
-	p.AddErrorListener(errListener)
-	//p.AddErrorListener(antlr.NewDiagnosticErrorListener(true))
-	p.BuildParseTrees = true
-	return p
-}
-
-func fillDetail(err interface{}, input antlr.CharStream) error {
-	switch x := err.(type) {
-	case *SemanticError:
-		x.FillDetail(input)
-		return x
-	case *ValidationError:
-		x.FillDetail(input)
-		return x
-	default:
-		panic(err) // TODO
-	}
-}
@@ -0,0 +1,36 @@
+package text
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zxh0/wasm.go/binary"
+)
+
+// hw_rust.wasm printed as a ~400KB .wat file
+func BenchmarkCompileModule(b *testing.B) {
+	for _, folded := range []bool{false, true} {
+		name := "flat"
+		if folded {
+			name = "folded"
+		}
+		wat := getBenchWAT(b, folded)
+		b.Run(name, func(b *testing.B) {
+			b.SetBytes(int64(len(wat)))
+			for i := 0; i < b.N; i++ {
+				if _, err := CompileModuleStr(wat); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func getBenchWAT(b *testing.B, folded bool) string {
+	m, err := binary.DecodeFile("../binary/testdata/hw_rust.wasm")
+	require.NoError(b, err)
+	sb := &strings.Builder{}
+	require.NoError(b, PrintWithOptions(m, sb, PrintOptions{Folded: folded}))
+	return sb.String()
+}
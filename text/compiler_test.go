@@ -2,10 +2,12 @@ package text
 
 import (
 	"io/ioutil"
+	"math"
 	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"github.com/zxh0/wasm.go/binary"
 )
 
 func TestCompileErrors(t *testing.T) {
@@ -44,3 +46,219 @@ func getExpectedErr(filename, wat string) string {
 	err := strings.TrimSpace(wat[start:end])
 	return strings.ReplaceAll(err, "err.wat", filename)
 }
+
+func TestCompileDiagnostics(t *testing.T) {
+	_, err := CompileScriptStr(`(module (func (call $f)))
+(assert_return (invoke "f" (i32.const 0x1_0000_0000)) (i32.const 0))`)
+	require.Equal(t, Diagnostics{
+		{File: "Obtained from string", Line: 1, Column: 21,
+			Msg:     "undefined function variable \"$f\"",
+			Snippet: "(module (func (call $f)))\n                    ^^"},
+		{File: "Obtained from string", Line: 2, Column: 39,
+			Msg:     "constant out of range",
+			Snippet: "(assert_return (invoke \"f\" (i32.const 0x1_0000_0000)) (i32.const 0))\n                                      ^^^^^^^^^^^^^"},
+	}, err)
+}
+
+func TestCompileExtendedConst(t *testing.T) {
+	m, err := CompileModuleStr(`(module
+  (global $g i32 (i32.const 8))
+  (global i64 (i64.mul (i64.const 2) (i64.const 3)))
+  (memory 1)
+  (data (offset global.get $g i32.const 4 i32.add) "hi"))`)
+	require.NoError(t, err)
+
+	require.Len(t, m.GlobalSec[1].Expr, 3)
+	require.Equal(t, byte(binary.I64Mul), m.GlobalSec[1].Expr[2].Opcode)
+	require.Len(t, m.DataSec[0].Offset, 3)
+	require.Equal(t, byte(binary.GlobalGet), m.DataSec[0].Offset[0].Opcode)
+	require.Equal(t, byte(binary.I32Add), m.DataSec[0].Offset[2].Opcode)
+}
+
+func TestCompileNames(t *testing.T) {
+	wat := `(module $m
+		(import "env" "f" (func $f (param $x i32)))
+		(func $add (param $a i32) (param i32) (result i32) (local $tmp i32)
+			(i32.add (local.get $a) (local.get 1)))
+		(func (nop)))`
+
+	m, err := CompileModuleStr(wat)
+	require.NoError(t, err)
+	require.Len(t, m.CustomSecs, 0)
+
+	m, err = CompileModuleStrWithOptions(wat, CompileOptions{Names: true})
+	require.NoError(t, err)
+	names, err := m.GetNameSec()
+	require.NoError(t, err)
+	require.Equal(t, &binary.NameSec{
+		ModuleName: "m",
+		FuncNames:  binary.NameMap{0: "f", 1: "add"},
+		LocalNames: map[binary.FuncIdx]binary.NameMap{
+			1: {0: "a", 2: "tmp"},
+		},
+	}, names)
+
+	m2, err := binary.Decode(binary.Encode(*m))
+	require.NoError(t, err)
+	require.Len(t, m2.CustomSecs, 1)
+	require.Equal(t, m.CustomSecs[0].Data, m2.CustomSecs[0].Data)
+}
+
+func TestCompileAnnotations(t *testing.T) {
+	m, err := CompileModuleStr(`(module $m (@name "M")
+		(@custom "a" "x" "y")
+		(import "env" "f" (func $f (@name "F") (param $x (@name "X") i32)))
+		(func $g (@foo (bar)) (@name "G") (param $y i32) (local $z (@name "Z") i32))
+		(@custom "b" (before func) "")
+		(@custom "c" (after last)))`)
+	require.NoError(t, err)
+	require.Equal(t, []binary.CustomSec{
+		{Name: "a", Data: []byte("xy"), After: binary.CustomSecLast},
+		{Name: "b", After: binary.SecImportID},
+		{Name: "c", After: binary.CustomSecLast},
+	}, m.CustomSecs[:3])
+
+	names, err := m.GetNameSec()
+	require.NoError(t, err)
+	require.Equal(t, &binary.NameSec{
+		ModuleName: "M",
+		FuncNames:  binary.NameMap{0: "F", 1: "G"},
+		LocalNames: map[binary.FuncIdx]binary.NameMap{1: {1: "Z"}},
+	}, names)
+
+	m, err = CompileModuleStrWithOptions(`(module $m (@name "M")
+		(func $g (param $y i32) (local $z (@name "Z") i32)))`, CompileOptions{Names: true})
+	require.NoError(t, err)
+	names, err = m.GetNameSec()
+	require.NoError(t, err)
+	require.Equal(t, &binary.NameSec{
+		ModuleName: "M",
+		FuncNames:  binary.NameMap{0: "g"},
+		LocalNames: map[binary.FuncIdx]binary.NameMap{0: {0: "y", 1: "Z"}},
+	}, names)
+
+	_, err = CompileModuleStr(`(module (@custom "a" (after nothing)))`)
+	require.Error(t, err)
+	_, err = CompileModuleStr(`(module (func (@custom "a")))`)
+	require.Error(t, err)
+}
+
+func TestCompileQuotedModule(t *testing.T) {
+	s, err := CompileScriptStr(`(module $m quote "(func (export \"f\"))" "\n(memory 1)")`)
+	require.NoError(t, err)
+	require.Equal(t, &QuotedModule{
+		Line: 1,
+		Name: "$m",
+		Text: "(func (export \"f\"))\n(memory 1)",
+	}, s.Cmds[0])
+}
+
+func TestCompileMeta(t *testing.T) {
+	s, err := CompileScriptStr("(input $m \"m.wat\")\n(output $m \"m.wasm\")\n(output)")
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{
+		&Meta{Line: 1, Kind: MetaInput, Name: "$m", FileName: "m.wat"},
+		&Meta{Line: 2, Kind: MetaOutput, Name: "$m", FileName: "m.wasm"},
+		&Meta{Line: 3, Kind: MetaOutput},
+	}, s.Cmds)
+}
+
+func TestCompileNestedScript(t *testing.T) {
+	s, err := CompileScriptStr(`(register "m")
+(script $s
+  (module $m) ;; (script
+  (script (get "g")) (; (script ;)
+  (invoke "(script"))
+(invoke "f")`)
+	require.NoError(t, err)
+	require.Len(t, s.Cmds, 3)
+	require.IsType(t, &Register{}, s.Cmds[0])
+	require.Equal(t, "f", s.Cmds[2].(*Action).ItemName)
+
+	m := s.Cmds[1].(*Meta)
+	require.Equal(t, byte(MetaScript), m.Kind)
+	require.Equal(t, "$s", m.Name)
+	require.Equal(t, 2, m.Line)
+	require.Len(t, m.Script.Cmds, 3)
+	require.Equal(t, "(script", m.Script.Cmds[2].(*Action).ItemName)
+	inner := m.Script.Cmds[1].(*Meta)
+	require.Equal(t, "", inner.Name)
+	require.Equal(t, "g", inner.Script.Cmds[0].(*Action).ItemName)
+
+	_, err = CompileScriptStr("(module)\n(script\n  (invoke \"f\" (i32.const)))")
+	require.Error(t, err)
+	require.True(t, strings.HasPrefix(err.Error(), "Obtained from string:3:25: "), err.Error())
+}
+
+func TestCompileAssertReturn(t *testing.T) {
+	s, err := CompileScriptStr(`(assert_return (invoke "f")
+		(i32.const -1) (f32.const nan:canonical) (f64.const nan:arithmetic) (f64.const -0))`)
+	require.NoError(t, err)
+	require.Equal(t, []Result{
+		{Kind: ResultConst, Type: "i32", Value: int32(-1)},
+		{Kind: ResultCanonicalNaN, Type: "f32"},
+		{Kind: ResultArithmeticNaN, Type: "f64"},
+		{Kind: ResultConst, Type: "f64", Value: math.Copysign(0, -1)},
+	}, s.Cmds[0].(*Assertion).Results)
+}
+
+func TestCompileAssertReturnRefsAndV128(t *testing.T) {
+	s, err := CompileScriptStr(`(assert_return (invoke "f")
+		(ref.null func) (ref.null extern) (ref.func) (ref.extern 1)
+		(v128.const i16x8 -1 0 1 2 3 4 5 0xffff)
+		(v128.const f32x4 nan:canonical 0 1 -1))`)
+	require.NoError(t, err)
+	i16 := func(v int32) Result { return Result{Kind: ResultConst, Type: "i16", Value: v} }
+	f32 := func(v float32) Result { return Result{Kind: ResultConst, Type: "f32", Value: v} }
+	require.Equal(t, []Result{
+		{Kind: ResultRefNull, Type: "func"},
+		{Kind: ResultRefNull, Type: "extern"},
+		{Kind: ResultRefFunc},
+		{Kind: ResultRefExtern, Value: uint32(1)},
+		{Kind: ResultV128, Type: "i16x8", Lanes: []Result{
+			i16(-1), i16(0), i16(1), i16(2), i16(3), i16(4), i16(5), i16(-1)}},
+		{Kind: ResultV128, Type: "f32x4", Lanes: []Result{
+			{Kind: ResultCanonicalNaN, Type: "f32"}, f32(0), f32(1), f32(-1)}},
+	}, s.Cmds[0].(*Assertion).Results)
+
+	_, err = CompileScriptStr(`(assert_return (invoke "f") (v128.const i32x4 1 2))`)
+	require.EqualError(t, err, `Obtained from string:1:41: error: wrong number of lane literals
+(assert_return (invoke "f") (v128.const i32x4 1 2))
+                                        ^^^^^`)
+}
+
+func TestCompileActionArgs(t *testing.T) {
+	s, err := CompileScriptStr(`(invoke "f" (i64.const -1) (ref.null extern) (ref.extern 2)
+  (v128.const i64x2 1 2))`)
+	require.NoError(t, err)
+	require.Equal(t, []Result{
+		{Kind: ResultConst, Type: "i64", Value: int64(-1)},
+		{Kind: ResultRefNull, Type: "extern"},
+		{Kind: ResultRefExtern, Value: uint32(2)},
+		{Kind: ResultV128, Type: "i64x2", Lanes: []Result{
+			{Kind: ResultConst, Type: "i64", Value: int64(1)},
+			{Kind: ResultConst, Type: "i64", Value: int64(2)}}},
+	}, s.Cmds[0].(*Action).Args)
+
+	_, err = CompileScriptStr(`(invoke "f" (ref.func))`)
+	require.EqualError(t, err, `Obtained from string:1:14: error: unexpected token "ref.func", expected constant
+(invoke "f" (ref.func))
+             ^^^^^^^^`)
+}
+
+func TestCompileSyntaxErrors(t *testing.T) {
+	_, err := CompileScriptStr(`(module (func i32.foo))
+(assert_return (invoke "f") (i32.const 1)
+(module)`)
+	require.EqualError(t, err, `Obtained from string:1:15: error: unexpected token "i32.foo", expected instruction
+(module (func i32.foo))
+              ^^^^^^^
+Obtained from string:3:9: error: unexpected end of input, expected ")"
+(module)
+        ^`)
+
+	_, err = CompileModuleStr("(module (func (nop)) (; x")
+	require.EqualError(t, err, `Obtained from string:1:22: error: unclosed comment
+(module (func (nop)) (; x
+                     ^^`)
+}
@@ -2,18 +2,15 @@ package text
 
 import (
 	"fmt"
+	"sort"
 	"strings"
-
-	"github.com/antlr/antlr4/runtime/Go/antlr"
 )
 
-var _ antlr.ErrorListener = (*ErrorListener)(nil)
-
 /* ValidationError */
 
 type ValidationError struct {
 	msg   string
-	token antlr.Token
+	token token
 }
 
 func newVerificationError(format string, a ...interface{}) *ValidationError {
@@ -26,15 +23,11 @@ func (e *ValidationError) Error() string {
 	return e.msg
 }
 
-func (e *ValidationError) FillDetail(input antlr.CharStream) {
-	e.msg = getErrDetail(e.msg, e.token, input)
-}
-
 /* SemanticError */
 
 type SemanticError struct {
 	msg   string
-	token antlr.Token
+	token token
 }
 
 func newSemanticError(format string, a ...interface{}) *SemanticError {
@@ -47,60 +40,108 @@ func (e *SemanticError) Error() string {
 	return e.msg
 }
 
-func (e *SemanticError) FillDetail(input antlr.CharStream) {
-	e.msg = getErrDetail(e.msg, e.token, input)
-}
-
 /* SyntaxError */
 
 type SyntaxError struct {
-	msg    string
-	line   int
-	column int
-	token  antlr.Token
+	msg   string
+	token token
+}
+
+func newSyntaxError(tok token, format string, a ...interface{}) *SyntaxError {
+	return &SyntaxError{
+		msg:   fmt.Sprintf(format, a...),
+		token: tok,
+	}
 }
 
-func (err SyntaxError) Error() string {
-	return err.msg
+func (e *SyntaxError) Error() string {
+	return e.msg
 }
 
-/* SyntaxErrors */
+/* Diagnostics */
 
-type SyntaxErrors []SyntaxError
+// Diagnostic is a compile error at a position of the source
+type Diagnostic struct {
+	File    string
+	Line    int // 0 if unknown
+	Column  int
+	Msg     string
+	Snippet string // source line and underline
+}
 
-func (errs SyntaxErrors) FillDetail(input antlr.CharStream) {
-	for i := range errs {
-		errs[i].msg = getErrDetail(errs[i].msg, errs[i].token, input)
+// gcc style: file:line:column: error: msg
+func (d Diagnostic) Error() string {
+	if d.Line == 0 {
+		return fmt.Sprintf("%s: error: %s", d.File, d.Msg)
 	}
+	return fmt.Sprintf("%s:%d:%d: error: %s\n%s",
+		d.File, d.Line, d.Column, d.Msg, d.Snippet)
 }
 
-func (errs SyntaxErrors) Error() string {
-	s := ""
-	for i, err := range errs {
-		s += err.Error()
-		if i < len(errs)-1 {
-			s += "\n"
-		}
+type Diagnostics []Diagnostic
+
+func (ds Diagnostics) Error() string {
+	s := make([]string, len(ds))
+	for i, d := range ds {
+		s[i] = d.Error()
 	}
-	return s
+	return strings.Join(s, "\n")
 }
 
-/* helpers */
+// errs are *SyntaxError, *SemanticError, *ValidationError or other errors
+func newDiagnostics(errs []error, sourceName, src string) Diagnostics {
+	var ds Diagnostics
+	seen := map[string]bool{}
+	for _, err := range errs {
+		d := newDiagnostic(err, sourceName, src)
+		if s := d.Error(); !seen[s] { // an error may be reported twice
+			seen[s] = true
+			ds = append(ds, d)
+		}
+	}
+	sort.SliceStable(ds, func(i, j int) bool { // errors of later passes come last
+		return ds[i].Line < ds[j].Line ||
+			ds[i].Line == ds[j].Line && ds[i].Column < ds[j].Column
+	})
+	return ds
+}
 
-func getErrDetail(msg string, token antlr.Token, input antlr.CharStream) string {
-	msg = fmt.Sprintf("%s:%d:%d: error: %s",
-		input.GetSourceName(), token.GetLine(), token.GetColumn()+1, msg)
-	allText := fmt.Sprintf("%s", input) // TODO
-	errLine := strings.Split(allText, "\n")[token.GetLine()-1]
-	underline := getUnderline(token.GetColumn(), token)
-	return msg + "\n" + errLine + "\n" + underline
+func newDiagnostic(err error, sourceName, src string) Diagnostic {
+	tok := token{}
+	switch x := err.(type) {
+	case *SyntaxError:
+		tok = x.token
+	case *SemanticError:
+		tok = x.token
+	case *ValidationError:
+		tok = x.token
+	}
+	d := Diagnostic{File: sourceName, Msg: err.Error()}
+	if tok.line > 0 {
+		d.Line = tok.line
+		d.Column = tok.column + 1
+		d.Snippet = getLine(src, tok.line) + "\n" + getUnderline(tok)
+	}
+	return d
 }
 
-func getUnderline(column int, token antlr.Token) string {
-	if token != nil {
-		return strings.Repeat(" ", token.GetColumn()) +
-			strings.Repeat("^", len(token.GetText()))
+func getLine(src string, line int) string {
+	for i := 1; i < line; i++ {
+		src = src[strings.IndexByte(src, '\n')+1:]
+	}
+	if end := strings.IndexByte(src, '\n'); end >= 0 {
+		src = src[:end]
 	}
+	return strings.TrimSuffix(src, "\r")
+}
 
-	return strings.Repeat(" ", column) + "^"
+func getUnderline(tok token) string {
+	n := len(tok.text)
+	if i := strings.IndexByte(tok.text, '\n'); i >= 0 {
+		n = i // multi-line string
+	}
+	if n == 0 {
+		n = 1
+	}
+	return strings.Repeat(" ", tok.column) + strings.Repeat("^", n)
 }
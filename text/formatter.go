@@ -0,0 +1,740 @@
+package text
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/zxh0/wasm.go/binary"
+)
+
+const (
+	StyleKeep   = iota // keep the style of the instructions
+	StyleFlat          // unfold folded instructions
+	StyleFolded        // fold operands into instructions (like PrintOptions.Folded)
+)
+
+type FormatOptions struct {
+	Style int // style of function bodies
+}
+
+// Format reprints a .wat module or .wast script with normalized indentation.
+// Comments, $names and the formats of literals are kept as they are.
+// The source must compile, it is compiled as a module if sourceName ends
+// with ".wat" and as a script otherwise.
+func Format(sourceName, src string, opts FormatOptions) (string, error) {
+	var err error
+	if strings.HasSuffix(sourceName, ".wat") {
+		_, err = CompileModule(sourceName, src)
+	} else {
+		_, err = CompileScript(sourceName, src)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	f := &formatter{opts: opts}
+	f.printElems(parseSExprs(tokenizeWithComments(src)), 0)
+	if len(f.lines) == 0 {
+		return "", nil
+	}
+	return strings.Join(f.lines, "\n") + "\n", nil
+}
+
+/* S-expressions */
+
+type sexpr struct {
+	tok      token    // atom, comment or "(" of a list
+	list     []*sexpr // elements of a list
+	end      token    // ")" of a list
+	trailing bool     // comment on the line of the previous token
+}
+
+// the source must be balanced
+func parseSExprs(toks []token) []*sexpr {
+	pos := 0
+	var parseList func() []*sexpr
+	parseList = func() []*sexpr {
+		var xs []*sexpr
+		for toks[pos].kind != tokRPar && toks[pos].kind != tokEOF {
+			x := &sexpr{tok: toks[pos]}
+			x.trailing = x.isComment() && pos > 0 &&
+				getTokenEndLine(toks[pos-1]) == x.tok.line
+			pos++
+			if x.tok.kind == tokLPar {
+				x.list = parseList()
+				x.end = toks[pos]
+				pos++
+			}
+			xs = append(xs, x)
+		}
+		return xs
+	}
+	return parseList()
+}
+
+func getTokenEndLine(tok token) int {
+	return tok.line + strings.Count(tok.text, "\n")
+}
+
+func (x *sexpr) isList() bool {
+	return x.tok.kind == tokLPar
+}
+func (x *sexpr) isComment() bool {
+	return x.tok.kind == tokComment
+}
+func (x *sexpr) isLineComment() bool {
+	return x.isComment() && strings.HasPrefix(x.tok.text, ";;")
+}
+func (x *sexpr) isInlineComment() bool {
+	return x.isComment() && !x.isLineComment() && !strings.Contains(x.tok.text, "\n")
+}
+func (x *sexpr) isAtom() bool {
+	return !x.isList() && !x.isComment()
+}
+
+// reports whether x is a folded instruction
+func (x *sexpr) isInstr() bool {
+	return x.isList() && len(x.list) > 0 && isInstr(x.list[0].tok)
+}
+
+// the keyword of a list
+func (x *sexpr) head() string {
+	if x.isList() && len(x.list) > 0 && (x.list[0].tok.kind == tokKeyword ||
+		x.list[0].tok.kind == tokReserved && x.list[0].tok.text[0] == '@') { // annotation
+		return x.list[0].tok.text
+	}
+	return ""
+}
+
+func (x *sexpr) endLine() int {
+	if x.isList() {
+		return x.end.line
+	}
+	return getTokenEndLine(x.tok)
+}
+
+func (x *sexpr) String() string {
+	if !x.isList() {
+		return strings.TrimRight(x.tok.text, " \t\r")
+	}
+	return "(" + joinSExprs(x.list) + ")"
+}
+
+func joinSExprs(xs []*sexpr) string {
+	ss := make([]string, len(xs))
+	for i, x := range xs {
+		ss[i] = x.String()
+	}
+	return strings.Join(ss, " ")
+}
+
+/* layout */
+
+// lists printed in the first line of the enclosing list
+var headerLists = map[string]bool{
+	"type": true, "param": true, "result": true, "export": true, "import": true,
+	"@name": true,
+}
+
+// returns the number of elements printed in the first line of a list
+func getHeaderLen(x *sexpr) int {
+	n := 1
+	if x.head() == "module" {
+		for n < len(x.list) && x.list[n].isAtom() && (x.list[n].tok.kind == tokName ||
+			x.list[n].tok.text == "binary" || x.list[n].tok.text == "quote") {
+			n++
+		}
+		if n < len(x.list) && x.list[n].head() == "@name" {
+			n++
+		}
+		return n
+	}
+	for n < len(x.list) && (x.list[n].isAtom() || x.list[n].isInlineComment()) {
+		n++
+	}
+	for n < len(x.list) && (headerLists[x.list[n].head()] && fitsInLine(x.list[n]) ||
+		x.list[n].isInlineComment()) {
+		n++
+	}
+	return n
+}
+
+// reports whether x is printed in one line
+func fitsInLine(x *sexpr) bool {
+	if !x.isList() {
+		return !x.isLineComment() && !strings.Contains(x.tok.text, "\n")
+	}
+	if len(x.list) == 0 {
+		return true
+	}
+
+	rest := x.list[getHeaderLen(x):]
+	switch x.head() {
+	case "module": // fields and binary modules are broken
+		if len(rest) > 1 || len(rest) == 1 && !rest[0].isAtom() {
+			return false
+		}
+	case "func", "block", "loop", "if", "then", "else":
+		if len(rest) > 0 {
+			return false
+		}
+	}
+	for _, e := range x.list {
+		if !fitsInLine(e) {
+			return false
+		}
+	}
+	return true
+}
+
+/* output */
+
+type formatter struct {
+	opts      FormatOptions
+	module    *fmtModule // the module being printed
+	lines     []string
+	indent    int
+	commented bool // the last line ends with a line comment
+}
+
+func (f *formatter) line(s string) {
+	f.lines = append(f.lines, strings.Repeat("  ", f.indent)+s)
+	f.commented = false
+}
+func (f *formatter) open(s string) {
+	f.line("(" + s)
+	f.indent++
+}
+func (f *formatter) close() {
+	f.indent--
+	if f.commented {
+		f.line(")")
+	} else {
+		f.lines[len(f.lines)-1] += ")"
+	}
+}
+
+// at most one blank line is kept between elements
+func (f *formatter) blankLine(prevEnd, start int) {
+	if prevEnd > 0 && start-prevEnd > 1 &&
+		len(f.lines) > 0 && f.lines[len(f.lines)-1] != "" {
+
+		f.lines = append(f.lines, "")
+		f.commented = false
+	}
+}
+
+// trailing comments stay in the line of the previous element
+func (f *formatter) printComment(x *sexpr) {
+	if x.trailing && len(f.lines) > 0 && f.lines[len(f.lines)-1] != "" {
+		f.lines[len(f.lines)-1] += " " + x.String()
+	} else {
+		f.line(x.String())
+	}
+	f.commented = x.isLineComment()
+}
+
+// prints each element in its own line
+func (f *formatter) printElems(xs []*sexpr, prevEnd int) {
+	for _, x := range xs {
+		f.blankLine(prevEnd, x.tok.line)
+		f.printElem(x)
+		prevEnd = x.endLine()
+	}
+}
+
+func (f *formatter) printElem(x *sexpr) {
+	switch {
+	case x.isComment():
+		f.printComment(x)
+	case x.isList():
+		f.printList(x)
+	default:
+		f.line(x.String())
+	}
+}
+
+func (f *formatter) printList(x *sexpr) {
+	if fitsInLine(x) {
+		f.line(x.String())
+		return
+	}
+
+	n := getHeaderLen(x)
+	f.open(joinSExprs(x.list[:n]))
+	rest, prevEnd := x.list[n:], x.list[n-1].endLine()
+	switch x.head() {
+	case "module":
+		outer := f.module
+		f.module = newFmtModule(x)
+		f.printElems(rest, prevEnd)
+		f.module = outer
+	case "func", "block", "loop", "if", "then", "else":
+		f.printBody(rest, prevEnd)
+	default:
+		f.printElems(rest, prevEnd)
+	}
+	f.close()
+}
+
+/* function bodies */
+
+// prints instructions and locals
+func (f *formatter) printBody(xs []*sexpr, prevEnd int) {
+	if f.opts.Style != StyleKeep {
+		body := f.unfoldExpr(xs)
+		if f.opts.Style == StyleFolded {
+			f.printFolded(f.fold(body))
+		} else {
+			f.printFlat(body)
+		}
+		return
+	}
+
+	for _, group := range groupInstrs(xs) {
+		x := group[0]
+		f.blankLine(prevEnd, x.tok.line)
+		prevEnd = group[len(group)-1].endLine()
+		switch {
+		case x.isInstr():
+			f.printFoldedInstr(x)
+		case x.tok.text == "end":
+			f.indent--
+			f.line(joinSExprs(group))
+		case x.tok.text == "else":
+			f.indent--
+			f.line(joinSExprs(group))
+			f.indent++
+		case isInstr(x.tok):
+			f.line(joinSExprs(group))
+			if isBlockInstr(x.tok.text) {
+				f.indent++
+			}
+		default:
+			f.printElem(x)
+		}
+	}
+}
+
+// operands are printed in their own lines
+func (f *formatter) printFoldedInstr(x *sexpr) {
+	if isBlockInstr(x.head()) {
+		f.printList(x)
+		return
+	}
+	n := getHeaderLen(x)
+	if n == len(x.list) {
+		f.line(x.String())
+		return
+	}
+	f.open(joinSExprs(x.list[:n]))
+	f.printBody(x.list[n:], x.list[n-1].endLine())
+	f.close()
+}
+
+// groups plain instructions with their immediates, the other elements
+// are in groups of their own
+func groupInstrs(xs []*sexpr) [][]*sexpr {
+	var groups [][]*sexpr
+	for i := 0; i < len(xs); i++ {
+		group := []*sexpr{xs[i]}
+		if isInstr(xs[i].tok) || isBlockEnd(xs[i].tok) {
+			for i+1 < len(xs) && isImmediate(xs[i+1]) {
+				i++
+				group = append(group, xs[i])
+			}
+		}
+		groups = append(groups, group)
+	}
+	return groups
+}
+
+func isBlockEnd(tok token) bool {
+	return tok.kind == tokKeyword && (tok.text == "end" || tok.text == "else")
+}
+
+// immediates, labels and block types
+func isImmediate(x *sexpr) bool {
+	switch x.tok.kind {
+	case tokLPar:
+		h := x.head()
+		return h == "type" || h == "param" || h == "result"
+	case tokKeyword:
+		return !isInstr(x.tok) && !isBlockEnd(x.tok)
+	case tokName, tokNum, tokString:
+		return true
+	}
+	return false
+}
+
+/* style conversion */
+
+// instruction of a function body, or another element if op is empty
+type fmtInstr struct {
+	op       string
+	header   []*sexpr // opname, immediates, label and block type
+	elem     *sexpr   // comment or local
+	body     []*fmtInstr
+	elseBody []*fmtInstr
+	blockEnd []*sexpr    // "end" with the optional label
+	operands []*fmtInstr // folded into the instruction
+	line     int
+	endLine  int
+}
+
+func newFmtInstr(group []*sexpr) *fmtInstr {
+	return &fmtInstr{
+		op:      group[0].tok.text,
+		header:  group,
+		line:    group[0].tok.line,
+		endLine: group[len(group)-1].endLine(),
+	}
+}
+
+// returns the instructions in execution order until "else" or "end"
+func (f *formatter) unfoldInstrs(groups [][]*sexpr, i *int) []*fmtInstr {
+	var instrs []*fmtInstr
+	for ; *i < len(groups); *i++ {
+		group := groups[*i]
+		x := group[0]
+		switch {
+		case x.isInstr():
+			instrs = append(instrs, f.unfoldList(x)...)
+		case isBlockEnd(x.tok):
+			return instrs
+		case isBlockInstr(x.tok.text):
+			instr := newFmtInstr(group)
+			*i++
+			instr.body = f.unfoldInstrs(groups, i)
+			if *i < len(groups) && groups[*i][0].tok.text == "else" {
+				*i++
+				instr.elseBody = f.unfoldInstrs(groups, i)
+			}
+			if *i < len(groups) {
+				instr.blockEnd = groups[*i]
+				instr.endLine = groups[*i][len(groups[*i])-1].endLine()
+			}
+			instrs = append(instrs, instr)
+		case isInstr(x.tok):
+			instrs = append(instrs, newFmtInstr(group))
+		default:
+			instrs = append(instrs, &fmtInstr{
+				elem:    x,
+				line:    x.tok.line,
+				endLine: x.endLine(),
+			})
+		}
+	}
+	return instrs
+}
+
+func (f *formatter) unfoldList(x *sexpr) []*fmtInstr {
+	n := getHeaderLen(x)
+	instr := &fmtInstr{
+		op:      x.head(),
+		header:  x.list[:n],
+		line:    x.tok.line,
+		endLine: x.end.line,
+	}
+	var operands []*fmtInstr
+	rest := x.list[n:]
+	switch instr.op {
+	case "block", "loop":
+		instr.body = f.unfoldExpr(rest)
+	case "if":
+		var conds []*sexpr
+		for _, e := range rest {
+			switch e.head() {
+			case "then":
+				instr.body = f.unfoldExpr(e.list[1:])
+			case "else":
+				instr.elseBody = f.unfoldExpr(e.list[1:])
+			default:
+				conds = append(conds, e)
+			}
+		}
+		operands = f.unfoldExpr(conds)
+	default:
+		operands = f.unfoldExpr(rest)
+	}
+	if len(operands) > 0 { // for blank lines
+		operands[0].line = instr.line
+	}
+	return append(operands, instr)
+}
+
+func (f *formatter) unfoldExpr(xs []*sexpr) []*fmtInstr {
+	i := 0
+	return f.unfoldInstrs(groupInstrs(xs), &i)
+}
+
+func (f *formatter) printFlat(instrs []*fmtInstr) {
+	prevEnd := 0
+	for _, instr := range instrs {
+		f.blankLine(prevEnd, instr.line)
+		prevEnd = instr.endLine
+		if instr.op == "" {
+			f.printElem(instr.elem)
+			continue
+		}
+		f.line(joinSExprs(instr.header))
+		if isBlockInstr(instr.op) {
+			f.indent++
+			f.printFlat(instr.body)
+			if len(instr.elseBody) > 0 {
+				f.indent--
+				f.line("else")
+				f.indent++
+				f.printFlat(instr.elseBody)
+			}
+			f.indent--
+			if instr.blockEnd != nil {
+				f.line(joinSExprs(instr.blockEnd))
+			} else {
+				f.line("end")
+			}
+		}
+	}
+}
+
+// Folds the operands of instructions with known arity into them,
+// the evaluation order is never changed (see printer.fold).
+func (f *formatter) fold(instrs []*fmtInstr) []*fmtInstr {
+	var nodes []*fmtInstr
+	avail := 0 // trailing nodes that produce exactly one value
+	for _, instr := range instrs {
+		params, results, ok := 0, 0, false
+		if instr.op != "" {
+			params, results, ok = f.arity(instr)
+			instr.body = f.fold(instr.body)
+			instr.elseBody = f.fold(instr.elseBody)
+		}
+		if ok && params <= avail {
+			instr.operands = append(instr.operands, nodes[len(nodes)-params:]...)
+			nodes = nodes[:len(nodes)-params]
+			avail -= params
+		} else {
+			avail = 0
+		}
+		nodes = append(nodes, instr)
+		if ok && results == 1 {
+			avail++
+		} else {
+			avail = 0
+		}
+	}
+	return nodes
+}
+
+func (f *formatter) printFolded(nodes []*fmtInstr) {
+	prevEnd := 0
+	for _, node := range nodes {
+		f.blankLine(prevEnd, node.getStartLine())
+		prevEnd = node.endLine
+		f.printNode(node)
+	}
+}
+
+// the first line of a folded node in the source
+func (instr *fmtInstr) getStartLine() int {
+	if len(instr.operands) > 0 {
+		if line := instr.operands[0].getStartLine(); line < instr.line {
+			return line
+		}
+	}
+	return instr.line
+}
+
+func (f *formatter) printNode(node *fmtInstr) {
+	if node.op == "" {
+		f.printElem(node.elem)
+		return
+	}
+	header := joinSExprs(node.header)
+	switch node.op {
+	case "block", "loop":
+		f.open(header)
+		f.printFolded(node.body)
+		f.close()
+	case "if":
+		f.open(header)
+		for _, operand := range node.operands {
+			f.printNode(operand)
+		}
+		f.open("then")
+		f.printFolded(node.body)
+		f.close()
+		if len(node.elseBody) > 0 {
+			f.open("else")
+			f.printFolded(node.elseBody)
+			f.close()
+		}
+		f.close()
+	default:
+		if len(node.operands) == 0 {
+			f.line("(" + header + ")")
+			return
+		}
+		f.open(header)
+		for _, operand := range node.operands {
+			f.printNode(operand)
+		}
+		f.close()
+	}
+}
+
+// returns the number of operands and results,
+// ok is false if they are unknown (see printer.arity)
+func (f *formatter) arity(instr *fmtInstr) (params, results int, ok bool) {
+	switch instr.op {
+	case "block", "loop", "if":
+		params, results, ok = f.module.getTypeUse(instr.header[1:])
+		if !ok || params > 0 {
+			return 0, 0, false
+		}
+		if instr.op == "if" {
+			return 1, results, true
+		}
+		return 0, results, true
+	case "call":
+		if len(instr.header) > 1 {
+			ft, ok := f.module.getFunc(instr.header[1].tok.text)
+			return ft.params, ft.results, ok
+		}
+		return 0, 0, false
+	case "call_indirect":
+		params, results, ok = f.module.getTypeUse(instr.header[1:])
+		return params + 1, results, ok
+	}
+	if opcode, found := binary.GetOpcode(instr.op); found {
+		return getInstrArity(opcode)
+	}
+	return 0, 0, false
+}
+
+/* function types */
+
+type fmtFuncType struct {
+	params  int
+	results int
+	known   bool
+}
+
+// function types of a module, used to fold calls
+type fmtModule struct {
+	types     []fmtFuncType
+	typeNames map[string]int
+	funcs     []fmtFuncType
+	funcNames map[string]int
+}
+
+func newFmtModule(x *sexpr) *fmtModule {
+	m := &fmtModule{typeNames: map[string]int{}, funcNames: map[string]int{}}
+	for _, field := range x.list[1:] {
+		if field.head() == "type" {
+			m.defineType(field)
+		}
+	}
+	// imported functions come first
+	for _, field := range x.list[1:] {
+		if field.head() == "import" && len(field.list) > 3 &&
+			field.list[3].head() == "func" {
+			m.defineFunc(field.list[3])
+		} else if field.head() == "func" && hasList(field, "import") {
+			m.defineFunc(field)
+		}
+	}
+	for _, field := range x.list[1:] {
+		if field.head() == "func" && !hasList(field, "import") {
+			m.defineFunc(field)
+		}
+	}
+	return m
+}
+
+func hasList(x *sexpr, head string) bool {
+	for _, e := range x.list {
+		if e.head() == head {
+			return true
+		}
+	}
+	return false
+}
+
+// (type $name? (func (param ...)* (result ...)*))
+func (m *fmtModule) defineType(x *sexpr) {
+	var ft fmtFuncType
+	for _, e := range x.list[1:] {
+		if e.head() == "func" {
+			ft.params, ft.results, ft.known = m.getTypeUse(e.list[1:])
+		} else if e.tok.kind == tokName {
+			m.typeNames[e.tok.text] = len(m.types)
+		}
+	}
+	m.types = append(m.types, ft)
+}
+
+// (func $name? ... typeUse ...)
+func (m *fmtModule) defineFunc(x *sexpr) {
+	if len(x.list) > 1 && x.list[1].tok.kind == tokName {
+		m.funcNames[x.list[1].tok.text] = len(m.funcs)
+	}
+	params, results, ok := m.getTypeUse(x.list[1:])
+	m.funcs = append(m.funcs, fmtFuncType{params, results, ok})
+}
+
+func (m *fmtModule) getFunc(_var string) (fmtFuncType, bool) {
+	if m == nil {
+		return fmtFuncType{}, false
+	}
+	idx, ok := getFmtIdx(_var, m.funcNames)
+	if !ok || idx >= len(m.funcs) {
+		return fmtFuncType{}, false
+	}
+	return m.funcs[idx], m.funcs[idx].known
+}
+
+// typeUse : ('(' 'type' var ')')? ('(' 'param' ... ')')* ('(' 'result' ... ')')*
+func (m *fmtModule) getTypeUse(xs []*sexpr) (params, results int, ok bool) {
+	if m == nil {
+		return 0, 0, false
+	}
+	typeIdx, inline := -1, false
+	for _, x := range xs {
+		switch x.head() {
+		case "type":
+			idx, found := getFmtIdx(x.list[len(x.list)-1].tok.text, m.typeNames)
+			if !found || idx >= len(m.types) {
+				return 0, 0, false
+			}
+			typeIdx = idx
+		case "param":
+			params += countTypes(x)
+			inline = true
+		case "result":
+			results += countTypes(x)
+			inline = true
+		}
+	}
+	if typeIdx >= 0 && !inline {
+		return m.types[typeIdx].params, m.types[typeIdx].results, true
+	}
+	return params, results, true
+}
+
+// (param $name type) or (param type*)
+func countTypes(x *sexpr) int {
+	if len(x.list) > 1 && x.list[1].tok.kind == tokName {
+		return 1
+	}
+	return len(x.list) - 1
+}
+
+func getFmtIdx(_var string, names map[string]int) (int, bool) {
+	if strings.HasPrefix(_var, "$") {
+		idx, ok := names[_var]
+		return idx, ok
+	}
+	idx, err := strconv.ParseUint(strings.ReplaceAll(_var, "_", ""), 0, 32)
+	return int(idx), err == nil
+}
@@ -0,0 +1,145 @@
+package text
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zxh0/wasm.go/binary"
+)
+
+const formatterTestWAT = `;; fixture
+(module $m
+(import "env" "f" (func $f (param i32 i32) (result i32)))   ;; imported
+      (func (export "g") (param $x i32) (result i32)
+    (local $y i64)
+
+
+    local.get $x
+       i32.const 0x10   (; hex ;)
+    i32.add
+  block $b (result i32)
+  i32.const 1_000
+    end $b
+    (call $f (local.get 0) (f32.const -0x1.8p3) (i32.trunc_f32_s)))
+  (memory 1) (data (i32.const 0) "a\n")
+)
+`
+
+func TestFormatKeep(t *testing.T) {
+	testFormat(t, StyleKeep, `;; fixture
+(module $m
+  (import "env" "f" (func $f (param i32 i32) (result i32))) ;; imported
+  (func (export "g") (param $x i32) (result i32)
+    (local $y i64)
+
+    local.get $x
+    i32.const 0x10 (; hex ;)
+    i32.add
+    block $b (result i32)
+      i32.const 1_000
+    end $b
+    (call $f
+      (local.get 0)
+      (f32.const -0x1.8p3)
+      (i32.trunc_f32_s)))
+  (memory 1)
+  (data (i32.const 0) "a\n"))
+`)
+}
+
+func TestFormatFlat(t *testing.T) {
+	testFormat(t, StyleFlat, `;; fixture
+(module $m
+  (import "env" "f" (func $f (param i32 i32) (result i32))) ;; imported
+  (func (export "g") (param $x i32) (result i32)
+    (local $y i64)
+
+    local.get $x
+    i32.const 0x10 (; hex ;)
+    i32.add
+    block $b (result i32)
+      i32.const 1_000
+    end $b
+    local.get 0
+    f32.const -0x1.8p3
+    i32.trunc_f32_s
+    call $f)
+  (memory 1)
+  (data (i32.const 0) "a\n"))
+`)
+}
+
+func TestFormatFolded(t *testing.T) {
+	testFormat(t, StyleFolded, `;; fixture
+(module $m
+  (import "env" "f" (func $f (param i32 i32) (result i32))) ;; imported
+  (func (export "g") (param $x i32) (result i32)
+    (local $y i64)
+
+    (local.get $x)
+    (i32.const 0x10) (; hex ;)
+    (i32.add)
+    (block $b (result i32)
+      (i32.const 1_000))
+    (call $f
+      (local.get 0)
+      (i32.trunc_f32_s
+        (f32.const -0x1.8p3))))
+  (memory 1)
+  (data (i32.const 0) "a\n"))
+`)
+}
+
+func testFormat(t *testing.T, style int, expected string) {
+	out, err := Format("x.wat", formatterTestWAT, FormatOptions{Style: style})
+	require.NoError(t, err)
+	require.Equal(t, expected, out)
+
+	out2, err := Format("x.wat", out, FormatOptions{Style: style})
+	require.NoError(t, err)
+	require.Equal(t, out, out2)
+}
+
+func TestFormatScript(t *testing.T) {
+	out, err := Format("x.wast", `(module (func (export "f") (result i32) (i32.const 1)))
+(assert_return (invoke "f") (i32.const 0x1))
+(assert_malformed (module quote "(func" " i32.const") "unexpected")
+(assert_invalid (module (func (result i32))) "type mismatch")`, FormatOptions{})
+	require.NoError(t, err)
+	require.Equal(t, `(module
+  (func (export "f") (result i32)
+    (i32.const 1)))
+(assert_return (invoke "f") (i32.const 0x1))
+(assert_malformed
+  (module quote
+    "(func"
+    " i32.const")
+  "unexpected")
+(assert_invalid
+  (module
+    (func (result i32)))
+  "type mismatch")
+`, out)
+
+	_, err = Format("x.wast", "(module (func (call $f)))", FormatOptions{})
+	require.Error(t, err)
+}
+
+func TestFormatPrinterOutput(t *testing.T) {
+	m, err := binary.DecodeFile("../binary/testdata/hw_rust.wasm")
+	require.NoError(t, err)
+	flat, folded := &strings.Builder{}, &strings.Builder{}
+	require.NoError(t, PrintWithOptions(m, flat, PrintOptions{}))
+	require.NoError(t, PrintWithOptions(m, folded, PrintOptions{Folded: true}))
+
+	out, err := Format("x.wat", flat.String(), FormatOptions{})
+	require.NoError(t, err)
+	require.Equal(t, flat.String(), out)
+	out, err = Format("x.wat", flat.String(), FormatOptions{Style: StyleFolded})
+	require.NoError(t, err)
+	require.Equal(t, folded.String(), out)
+	out, err = Format("x.wat", folded.String(), FormatOptions{Style: StyleFlat})
+	require.NoError(t, err)
+	require.Equal(t, flat.String(), out)
+}
@@ -0,0 +1,354 @@
+package text
+
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	tokEOF      = iota
+	tokLPar     // (
+	tokRPar     // )
+	tokKeyword  // module, i32.add, offset=8, nan:canonical ...
+	tokName     // $x
+	tokString   // "..."
+	tokNum      // 1, -0x1p3, inf, nan:0x1 ...
+	tokReserved // any other idchars
+	tokComment  // ;; ... or (; ... ;), only kept for formatting
+)
+
+type token struct {
+	kind   int
+	text   string
+	line   int // 0 if unknown
+	column int
+}
+
+// https://webassembly.github.io/spec/core/text/lexical.html
+type lexer struct {
+	src      string
+	pos      int
+	line     int
+	column   int
+	toks     []token
+	comments bool // keep comments as tokens
+}
+
+// annotations other than @custom and @name are dropped
+func tokenize(src string) []token {
+	return skipAnnotations(newLexer(src, false).tokenize())
+}
+
+// the comments are kept as tokComment
+func tokenizeWithComments(src string) []token {
+	return newLexer(src, true).tokenize()
+}
+
+func newLexer(src string, comments bool) *lexer {
+	return &lexer{src: src, line: 1, comments: comments}
+}
+
+func (l *lexer) tokenize() []token {
+	l.toks = make([]token, 0, len(l.src)/4)
+	for {
+		l.skipSpaces()
+		if l.pos >= len(l.src) {
+			l.toks = append(l.toks, token{kind: tokEOF, line: l.line, column: l.column})
+			return l.toks
+		}
+		l.toks = append(l.toks, l.nextToken())
+	}
+}
+
+func (l *lexer) nextToken() token {
+	start, line, column := l.pos, l.line, l.column
+	kind := tokReserved
+	switch c := l.src[l.pos]; {
+	case l.isCommentStart():
+		kind = tokComment
+		if l.src[l.pos] == ';' {
+			l.skipLineComment()
+		} else {
+			l.skipBlockComment()
+		}
+	case c == '(':
+		kind = tokLPar
+		l.advance(1)
+	case c == ')':
+		kind = tokRPar
+		l.advance(1)
+	case c == '"':
+		kind = tokString
+		l.skipString(token{line: line, column: column, text: `"`})
+	case isIdChar(c):
+		for l.pos < len(l.src) && isIdChar(l.src[l.pos]) {
+			l.advance(1)
+		}
+		kind = getTokenKind(l.src[start:l.pos])
+	default:
+		_, n := utf8.DecodeRuneInString(l.src[l.pos:])
+		panic(&SyntaxError{
+			msg:   "unexpected character",
+			token: token{text: l.src[l.pos : l.pos+n], line: line, column: column},
+		})
+	}
+	return token{kind: kind, text: l.src[start:l.pos], line: line, column: column}
+}
+
+// https://github.com/WebAssembly/annotations
+// Unknown annotations like (@foo ...) are ignored, unbalanced ones are
+// kept for the parser to report.
+func skipAnnotations(toks []token) []token {
+	out := toks[:0]
+	for i := 0; i < len(toks); i++ {
+		if isAnnotStart(toks, i) && !isKnownAnnot(toks[i+1].text) {
+			if end := getSExprEnd(toks, i); end > 0 {
+				i = end - 1
+				continue
+			}
+		}
+		out = append(out, toks[i])
+	}
+	return out
+}
+
+func isAnnotStart(toks []token, i int) bool {
+	return toks[i].kind == tokLPar && i+1 < len(toks) &&
+		toks[i+1].kind == tokReserved && len(toks[i+1].text) > 1 &&
+		toks[i+1].text[0] == '@'
+}
+
+func isKnownAnnot(s string) bool {
+	return s == "@custom" || s == "@name"
+}
+
+// the index after the ")" closing toks[i], 0 if unbalanced
+func getSExprEnd(toks []token, i int) int {
+	depth := 0
+	for ; i < len(toks); i++ {
+		switch toks[i].kind {
+		case tokLPar:
+			depth++
+		case tokRPar:
+			if depth--; depth == 0 {
+				return i + 1
+			}
+		}
+	}
+	return 0
+}
+
+func (l *lexer) advance(n int) {
+	for i := 0; i < n; i++ {
+		if l.src[l.pos] == '\n' {
+			l.line++
+			l.column = 0
+		} else {
+			l.column++
+		}
+		l.pos++
+	}
+}
+
+// whitespace and comments
+func (l *lexer) skipSpaces() {
+	for l.pos < len(l.src) {
+		switch c := l.src[l.pos]; {
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			l.advance(1)
+		case l.isCommentStart() && !l.comments:
+			if l.src[l.pos] == ';' {
+				l.skipLineComment()
+			} else {
+				l.skipBlockComment()
+			}
+		default:
+			return
+		}
+	}
+}
+
+func (l *lexer) isCommentStart() bool {
+	s := l.src[l.pos:]
+	return strings.HasPrefix(s, ";;") || strings.HasPrefix(s, "(;")
+}
+
+// the newline is not included
+func (l *lexer) skipLineComment() {
+	for l.pos < len(l.src) && l.src[l.pos] != '\n' {
+		l.advance(1)
+	}
+}
+
+func (l *lexer) skipBlockComment() {
+	start := token{text: "(;", line: l.line, column: l.column}
+	depth := 0
+	for l.pos < len(l.src) {
+		if strings.HasPrefix(l.src[l.pos:], "(;") {
+			depth++
+			l.advance(2)
+		} else if strings.HasPrefix(l.src[l.pos:], ";)") {
+			depth--
+			l.advance(2)
+			if depth == 0 {
+				return
+			}
+		} else {
+			l.advance(1)
+		}
+	}
+	panic(&SyntaxError{msg: "unclosed comment", token: start})
+}
+
+func (l *lexer) skipString(start token) {
+	l.advance(1)
+	for l.pos < len(l.src) {
+		c := l.src[l.pos]
+		switch {
+		case c == '"':
+			l.advance(1)
+			return
+		case c == '\\':
+			l.skipEscape()
+		case c < 0x20 || c == 0x7f:
+			l.errAt("illegal control character in string", 1)
+		default:
+			l.advance(1)
+		}
+	}
+	panic(&SyntaxError{msg: "unclosed string", token: start})
+}
+
+func (l *lexer) skipEscape() {
+	s := l.src[l.pos:]
+	if len(s) < 2 {
+		l.errAt("illegal escape", len(s))
+	}
+	switch s[1] {
+	case 't', 'n', 'r', '"', '\'', '\\':
+		l.advance(2)
+	case 'u':
+		end := strings.IndexByte(s, '}')
+		if !strings.HasPrefix(s, `\u{`) || end < 4 ||
+			!isHexNum(s[3:end]) || !isValidRune(s[3:end]) {
+			l.errAt("illegal escape", 2)
+		}
+		l.advance(end + 1)
+	default:
+		if len(s) < 3 || !isHexDigit(s[1]) || !isHexDigit(s[2]) {
+			l.errAt("illegal escape", 2)
+		}
+		l.advance(3)
+	}
+}
+
+func (l *lexer) errAt(msg string, n int) {
+	panic(&SyntaxError{
+		msg:   msg,
+		token: token{text: l.src[l.pos : l.pos+n], line: l.line, column: l.column},
+	})
+}
+
+func isValidRune(hex string) bool {
+	r, err := strconv.ParseUint(strings.ReplaceAll(hex, "_", ""), 16, 32)
+	return err == nil && (r < 0xD800 || r >= 0xE000 && r < 0x110000)
+}
+
+/* token kinds */
+
+func getTokenKind(s string) int {
+	switch {
+	case s[0] == '$':
+		if len(s) > 1 {
+			return tokName
+		}
+	case isNum(s):
+		return tokNum
+	case s[0] >= 'a' && s[0] <= 'z':
+		return tokKeyword
+	}
+	return tokReserved
+}
+
+func isIdChar(c byte) bool {
+	return c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' ||
+		strings.IndexByte("!#$%&'*+-./:<=>?@\\^_`|~", c) >= 0
+}
+
+// integers and floats, sign included
+func isNum(s string) bool {
+	if s[0] == '+' || s[0] == '-' {
+		s = s[1:]
+	}
+	switch {
+	case s == "inf" || s == "nan":
+		return true
+	case strings.HasPrefix(s, "nan:0x"):
+		return isHexNum(s[6:])
+	case strings.HasPrefix(s, "0x"):
+		return isFloatNum(s[2:], isHexNum, "pP")
+	default:
+		return isFloatNum(s, isDecNum, "eE")
+	}
+}
+
+// num ('.' num?)? ([eE] sign? decnum)?
+func isFloatNum(s string, isNum func(string) bool, exps string) bool {
+	mantissa, exp := s, ""
+	if i := strings.IndexAny(s, exps); i >= 0 {
+		mantissa, exp = s[:i], s[i+1:]
+		if exp != "" && (exp[0] == '+' || exp[0] == '-') {
+			exp = exp[1:]
+		}
+		if !isDecNum(exp) {
+			return false
+		}
+	}
+	if i := strings.IndexByte(mantissa, '.'); i >= 0 {
+		frac := mantissa[i+1:]
+		mantissa = mantissa[:i]
+		if frac != "" && !isNum(frac) {
+			return false
+		}
+	}
+	return isNum(mantissa)
+}
+
+// digit ('_'? digit)*
+func isDecNum(s string) bool {
+	return isDigits(s, func(c byte) bool { return c >= '0' && c <= '9' })
+}
+func isHexNum(s string) bool {
+	return isDigits(s, isHexDigit)
+}
+func isDigits(s string, isDigit func(byte) bool) bool {
+	if s == "" || !isDigit(s[0]) || !isDigit(s[len(s)-1]) {
+		return false
+	}
+	for i := 1; i < len(s)-1; i++ {
+		if !isDigit(s[i]) && !(s[i] == '_' && isDigit(s[i+1])) {
+			return false
+		}
+	}
+	return true
+}
+func isHexDigit(c byte) bool {
+	return c >= '0' && c <= '9' || c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F'
+}
+
+// unsigned integer
+func isNat(s string) bool {
+	if strings.HasPrefix(s, "0x") {
+		return isHexNum(s[2:])
+	}
+	return isDecNum(s)
+}
+
+// signed or unsigned integer
+func isInt(s string) bool {
+	if s != "" && (s[0] == '+' || s[0] == '-') {
+		s = s[1:]
+	}
+	return isNat(s)
+}
@@ -0,0 +1,53 @@
+package text
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTokenize(t *testing.T) {
+	toks := tokenize(`(module $m ;; comment
+  (; block (; nested ;) ;) (data "a\"b\u{1F600}")
+  i32.const -0x1p3 offset=8 nan:canonical 1.0_ $)`)
+	kinds := make([]int, len(toks))
+	texts := make([]string, len(toks))
+	for i, tok := range toks {
+		kinds[i], texts[i] = tok.kind, tok.text
+	}
+	require.Equal(t, []int{tokLPar, tokKeyword, tokName, tokLPar, tokKeyword,
+		tokString, tokRPar, tokKeyword, tokNum, tokKeyword, tokKeyword,
+		tokReserved, tokReserved, tokRPar, tokEOF}, kinds)
+	require.Equal(t, []string{"(", "module", "$m", "(", "data",
+		`"a\"b\u{1F600}"`, ")", "i32.const", "-0x1p3", "offset=8",
+		"nan:canonical", "1.0_", "$", ")", ""}, texts)
+	require.Equal(t, token{kind: tokLPar, text: "(", line: 2, column: 27}, toks[3])
+}
+
+func TestTokenizeNums(t *testing.T) {
+	for _, s := range []string{"0", "+1", "-1_000", "0xFF_ff", "1.", "1.5e-3",
+		"0x1.8p+1", "inf", "-nan", "nan:0x7f_ffff", "1E10"} {
+		require.True(t, isNum(s), s)
+	}
+	for _, s := range []string{"_1", "1__0", "1_", "0x", "1e", "0x1.g", "nan:0x"} {
+		require.False(t, isNum(s), s)
+	}
+}
+
+func TestTokenizeErrors(t *testing.T) {
+	for src, msg := range map[string]string{
+		`"abc`:                        "unclosed string",
+		`"\q"`:                        "illegal escape",
+		`"\u{D800}"`:                  "illegal escape",
+		`"\u{1_0000_0000_0000_0000}"`: "illegal escape",
+		"(; ":                         "unclosed comment",
+		"{":                           "unexpected character",
+	} {
+		require.PanicsWithValue(t, msg, func() {
+			defer func() {
+				panic(recover().(*SyntaxError).Error())
+			}()
+			tokenize(src)
+		}, src)
+	}
+}
@@ -7,6 +7,14 @@ import (
 )
 
 func parseU32(s string) uint32 {
+	return uint32(parseUint(s, 32))
+}
+
+func parseU64(s string) uint64 {
+	return parseUint(s, 64)
+}
+
+func parseUint(s string, bitSize int) uint64 {
 	base := 10
 	s = strings.ReplaceAll(s, "_", "")
 	if strings.Index(s, "0x") >= 0 {
@@ -14,11 +22,11 @@ func parseU32(s string) uint32 {
 		s = strings.Replace(s, "0x", "", 1)
 	}
 
-	i, err := strconv.ParseUint(s, base, 32)
+	i, err := strconv.ParseUint(s, base, bitSize)
 	if err != nil {
-		panic(err) // TODO
+		panic(newSemanticError("constant out of range"))
 	}
-	return uint32(i)
+	return i
 }
 
 func parseI32(s string) int32 {
@@ -52,7 +60,7 @@ func parseInt(s string, bitSize int) int64 {
 	}
 
 	if err != nil {
-		panic(err) // TODO
+		panic(newSemanticError("constant out of range"))
 	}
 	return i
 }
@@ -82,7 +90,7 @@ func parseFloat(s string, bitSize int) float64 {
 
 	f, err := strconv.ParseFloat(s, bitSize)
 	if err != nil {
-		panic(err) // TODO
+		panic(newSemanticError("constant out of range"))
 	}
 	return f
 }
@@ -95,8 +103,8 @@ func parseNaN32(s string) float32 {
 	}
 	if strings.HasPrefix(s, "nan:0x") {
 		payload, err := strconv.ParseUint(s[6:], 16, 32)
-		if err != nil {
-			panic(err)
+		if err != nil || payload == 0 || payload > 0x7FFFFF {
+			panic(newSemanticError("constant out of range"))
 		}
 		bits := math.Float32bits(f) & 0xFFBFFFFF
 		f = math.Float32frombits(bits | uint32(payload))
@@ -112,8 +120,8 @@ func parseNaN64(s string) float64 {
 	}
 	if strings.HasPrefix(s, "nan:0x") {
 		payload, err := strconv.ParseUint(s[6:], 16, 64)
-		if err != nil {
-			panic(err)
+		if err != nil || payload == 0 || payload > 0xFFFFFFFFFFFFF {
+			panic(newSemanticError("constant out of range"))
 		}
 		bits := math.Float64bits(f) & 0xFFF7FFFFFFFFFFFE
 		f = math.Float64frombits(bits | payload)
@@ -0,0 +1,192 @@
+package text
+
+import (
+	"runtime"
+	"strings"
+)
+
+// recursive descent parser over the tokens of a .wat or .wast file,
+// syntax errors are panicked
+type parser struct {
+	toks []token
+	pos  int
+	errs []error
+}
+
+func (p *parser) peek() token {
+	return p.toks[p.pos]
+}
+
+// peeks the nth token after the current one
+func (p *parser) peekN(n int) token {
+	if p.pos+n < len(p.toks) {
+		return p.toks[p.pos+n]
+	}
+	return p.toks[len(p.toks)-1] // EOF
+}
+
+func (p *parser) next() token {
+	tok := p.toks[p.pos]
+	if tok.kind != tokEOF {
+		p.pos++
+	}
+	return tok
+}
+
+func (p *parser) expect(kind int) token {
+	if tok := p.peek(); tok.kind != kind {
+		p.errUnexpected(tok, tokenKindNames[kind])
+	}
+	return p.next()
+}
+
+func (p *parser) expectKeyword(kw string) token {
+	if tok := p.peek(); tok.kind != tokKeyword || tok.text != kw {
+		p.errUnexpected(tok, `"`+kw+`"`)
+	}
+	return p.next()
+}
+
+// reports whether the next tokens are "(" kw
+func (p *parser) isLPar(kw string) bool {
+	return p.peek().kind == tokLPar &&
+		p.peekN(1).kind == tokKeyword && p.peekN(1).text == kw
+}
+
+// consumes "(" kw if present
+func (p *parser) lpar(kw string) bool {
+	if p.isLPar(kw) {
+		p.pos += 2
+		return true
+	}
+	return false
+}
+
+// reports whether the next tokens are "(" id, id is an annotation like @name
+func (p *parser) isAnnot(id string) bool {
+	return p.peek().kind == tokLPar &&
+		p.peekN(1).kind == tokReserved && p.peekN(1).text == id
+}
+
+func (p *parser) rpar() {
+	p.expect(tokRPar)
+}
+
+// returns the optional $name, or an empty token
+func (p *parser) optName() token {
+	if p.peek().kind == tokName {
+		return p.next()
+	}
+	return token{}
+}
+
+// returns the optional NAT or $name, or an empty token
+func (p *parser) optVar() token {
+	if tok := p.peek(); tok.kind == tokName || tok.kind == tokNum && isNat(tok.text) {
+		return p.next()
+	}
+	return token{}
+}
+
+func (p *parser) variable() token {
+	tok := p.optVar()
+	if tok.text == "" {
+		p.errUnexpected(p.peek(), "index or name")
+	}
+	return tok
+}
+
+func (p *parser) nat() token {
+	if tok := p.peek(); tok.kind != tokNum || !isNat(tok.text) {
+		p.errUnexpected(tok, "natural number")
+	}
+	return p.next()
+}
+
+// the unescaped string
+func (p *parser) str() string {
+	return string(escape(getStr(p.expect(tokString))))
+}
+
+// the unescaped strings concatenated
+func (p *parser) strs() []byte {
+	var data []byte
+	for p.peek().kind == tokString {
+		data = append(data, escape(getStr(p.next()))...)
+	}
+	return data
+}
+
+// skips a parenthesized S-expression and returns its end
+func (p *parser) skipSExpr() int {
+	p.expect(tokLPar)
+	for depth := 1; depth > 0; {
+		switch p.next().kind {
+		case tokLPar:
+			depth++
+		case tokRPar:
+			depth--
+		case tokEOF:
+			p.errUnexpected(p.peek(), `")"`)
+		}
+	}
+	return p.pos
+}
+
+func (p *parser) errUnexpected(tok token, expected string) {
+	if tok.kind == tokEOF {
+		panic(newSyntaxError(tok, "unexpected end of input, expected %s", expected))
+	}
+	panic(newSyntaxError(tok, "unexpected token %s, expected %s",
+		getTokenDesc(tok), expected))
+}
+
+var tokenKindNames = []string{
+	tokEOF:      "end of input",
+	tokLPar:     `"("`,
+	tokRPar:     `")"`,
+	tokKeyword:  "keyword",
+	tokName:     "name",
+	tokString:   "string",
+	tokNum:      "number",
+	tokReserved: "reserved token",
+	tokComment:  "comment",
+}
+
+func getTokenDesc(tok token) string {
+	text := tok.text
+	if i := strings.IndexByte(text, '\n'); i >= 0 {
+		text = text[:i] + "..."
+	}
+	return `"` + text + `"`
+}
+
+func getStr(tok token) string {
+	return tok.text[1 : len(tok.text)-1]
+}
+
+/* error recovery */
+
+// runs f, an error only stops f and is collected
+func (p *parser) try(f func()) (ok bool) {
+	defer p.recoverErr()
+	f()
+	return true
+}
+
+func (p *parser) recoverErr() {
+	if r := recover(); r != nil {
+		p.addErr(r)
+	}
+}
+
+// collects compile errors, bugs are panicked again
+func (p *parser) addErr(r interface{}) {
+	if err, ok := r.(error); ok {
+		if _, ok := err.(runtime.Error); !ok {
+			p.errs = append(p.errs, err)
+			return
+		}
+	}
+	panic(r)
+}
@@ -1,782 +0,0 @@
-// Code generated from ./text/grammar/WAST.g4 by ANTLR 4.8. DO NOT EDIT.
-
-package parser
-
-import (
-	"fmt"
-	"unicode"
-
-	"github.com/antlr/antlr4/runtime/Go/antlr"
-)
-
-// Suppress unused import error
-var _ = fmt.Printf
-var _ = unicode.IsLetter
-
-var serializedLexerAtn = []uint16{
-	3, 24715, 42794, 33075, 47597, 16764, 15335, 30598, 22884, 2, 70, 1333,
-	8, 1, 4, 2, 9, 2, 4, 3, 9, 3, 4, 4, 9, 4, 4, 5, 9, 5, 4, 6, 9, 6, 4, 7,
-	9, 7, 4, 8, 9, 8, 4, 9, 9, 9, 4, 10, 9, 10, 4, 11, 9, 11, 4, 12, 9, 12,
-	4, 13, 9, 13, 4, 14, 9, 14, 4, 15, 9, 15, 4, 16, 9, 16, 4, 17, 9, 17, 4,
-	18, 9, 18, 4, 19, 9, 19, 4, 20, 9, 20, 4, 21, 9, 21, 4, 22, 9, 22, 4, 23,
-	9, 23, 4, 24, 9, 24, 4, 25, 9, 25, 4, 26, 9, 26, 4, 27, 9, 27, 4, 28, 9,
-	28, 4, 29, 9, 29, 4, 30, 9, 30, 4, 31, 9, 31, 4, 32, 9, 32, 4, 33, 9, 33,
-	4, 34, 9, 34, 4, 35, 9, 35, 4, 36, 9, 36, 4, 37, 9, 37, 4, 38, 9, 38, 4,
-	39, 9, 39, 4, 40, 9, 40, 4, 41, 9, 41, 4, 42, 9, 42, 4, 43, 9, 43, 4, 44,
-	9, 44, 4, 45, 9, 45, 4, 46, 9, 46, 4, 47, 9, 47, 4, 48, 9, 48, 4, 49, 9,
-	49, 4, 50, 9, 50, 4, 51, 9, 51, 4, 52, 9, 52, 4, 53, 9, 53, 4, 54, 9, 54,
-	4, 55, 9, 55, 4, 56, 9, 56, 4, 57, 9, 57, 4, 58, 9, 58, 4, 59, 9, 59, 4,
-	60, 9, 60, 4, 61, 9, 61, 4, 62, 9, 62, 4, 63, 9, 63, 4, 64, 9, 64, 4, 65,
-	9, 65, 4, 66, 9, 66, 4, 67, 9, 67, 4, 68, 9, 68, 4, 69, 9, 69, 4, 70, 9,
-	70, 4, 71, 9, 71, 4, 72, 9, 72, 4, 73, 9, 73, 4, 74, 9, 74, 4, 75, 9, 75,
-	4, 76, 9, 76, 4, 77, 9, 77, 4, 78, 9, 78, 4, 79, 9, 79, 4, 80, 9, 80, 4,
-	81, 9, 81, 4, 82, 9, 82, 4, 83, 9, 83, 4, 84, 9, 84, 4, 85, 9, 85, 3, 2,
-	3, 2, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 4, 3, 4,
-	3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 5, 3, 6, 3, 6, 3, 6, 3, 6, 3, 6,
-	3, 6, 3, 6, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 7, 3, 8, 3, 8, 3, 8, 3, 8,
-	3, 8, 3, 8, 3, 8, 3, 9, 3, 9, 3, 9, 3, 9, 3, 10, 3, 10, 3, 10, 3, 10, 3,
-	10, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 3, 10, 3, 11,
-	3, 11, 3, 11, 3, 11, 3, 11, 3, 11, 3, 11, 3, 11, 3, 11, 3, 11, 3, 11, 3,
-	11, 3, 12, 3, 12, 3, 12, 3, 12, 3, 12, 3, 12, 3, 12, 3, 12, 3, 12, 3, 12,
-	3, 12, 3, 12, 3, 12, 3, 12, 3, 12, 3, 12, 3, 12, 3, 12, 3, 13, 3, 13, 3,
-	13, 3, 13, 3, 13, 3, 13, 3, 13, 3, 13, 3, 13, 3, 13, 3, 13, 3, 13, 3, 13,
-	3, 13, 3, 13, 3, 13, 3, 13, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3,
-	14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 14, 3, 15, 3, 15,
-	3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3,
-	15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 15, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16,
-	3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 16, 3, 17, 3,
-	17, 3, 17, 3, 17, 3, 17, 3, 17, 3, 17, 3, 17, 3, 17, 3, 17, 3, 17, 3, 17,
-	3, 17, 3, 17, 3, 17, 3, 18, 3, 18, 3, 18, 3, 18, 3, 18, 3, 18, 3, 18, 3,
-	19, 3, 19, 3, 19, 3, 19, 3, 19, 3, 19, 3, 20, 3, 20, 3, 20, 3, 20, 3, 20,
-	3, 20, 3, 20, 3, 21, 3, 21, 3, 21, 3, 21, 3, 21, 3, 22, 3, 22, 3, 22, 3,
-	22, 3, 22, 3, 23, 3, 23, 3, 23, 3, 23, 3, 23, 3, 23, 3, 23, 3, 24, 3, 24,
-	3, 24, 3, 24, 3, 24, 3, 24, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 3, 25, 3,
-	25, 3, 26, 3, 26, 3, 26, 3, 26, 3, 26, 3, 26, 3, 26, 3, 27, 3, 27, 3, 27,
-	3, 27, 3, 27, 3, 27, 3, 28, 3, 28, 3, 28, 3, 28, 3, 28, 3, 29, 3, 29, 3,
-	29, 3, 29, 3, 29, 3, 30, 3, 30, 3, 30, 3, 30, 3, 30, 3, 30, 3, 30, 3, 31,
-	3, 31, 3, 31, 3, 31, 3, 31, 3, 31, 3, 32, 3, 32, 3, 32, 3, 32, 3, 32, 3,
-	32, 3, 32, 3, 33, 3, 33, 3, 33, 3, 33, 3, 34, 3, 34, 3, 34, 3, 34, 3, 34,
-	3, 34, 3, 34, 3, 34, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 35, 3, 36, 3,
-	36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 36, 3, 37, 3, 37, 3, 37, 3, 37, 3, 37,
-	3, 37, 3, 38, 3, 38, 3, 38, 3, 38, 3, 38, 3, 39, 3, 39, 3, 39, 3, 40, 3,
-	40, 3, 40, 3, 40, 3, 40, 3, 41, 3, 41, 3, 41, 3, 41, 3, 41, 3, 42, 3, 42,
-	3, 42, 3, 42, 3, 43, 3, 43, 3, 43, 3, 43, 3, 43, 3, 43, 3, 43, 3, 43, 3,
-	43, 3, 43, 3, 43, 3, 43, 3, 44, 3, 44, 3, 44, 3, 44, 3, 45, 3, 45, 3, 45,
-	3, 46, 3, 46, 3, 46, 3, 46, 3, 46, 3, 46, 3, 47, 3, 47, 3, 47, 3, 47, 3,
-	47, 3, 47, 3, 47, 3, 47, 3, 47, 3, 48, 3, 48, 3, 48, 3, 48, 3, 48, 3, 48,
-	3, 48, 3, 49, 3, 49, 3, 49, 3, 49, 3, 49, 3, 50, 3, 50, 3, 50, 3, 50, 3,
-	50, 3, 50, 3, 50, 3, 50, 3, 50, 3, 50, 3, 50, 3, 50, 3, 50, 3, 50, 3, 51,
-	3, 51, 3, 51, 3, 51, 3, 51, 3, 52, 3, 52, 3, 52, 3, 52, 3, 52, 3, 52, 3,
-	52, 3, 53, 3, 53, 3, 53, 3, 53, 3, 53, 3, 53, 3, 53, 3, 53, 3, 53, 3, 53,
-	3, 53, 3, 53, 3, 54, 3, 54, 3, 54, 3, 54, 3, 54, 3, 54, 3, 54, 3, 54, 3,
-	54, 3, 54, 3, 54, 3, 54, 3, 55, 3, 55, 3, 56, 3, 56, 3, 56, 3, 56, 3, 56,
-	3, 56, 3, 57, 3, 57, 3, 58, 3, 58, 6, 58, 593, 10, 58, 13, 58, 14, 58,
-	594, 3, 59, 3, 59, 3, 59, 7, 59, 600, 10, 59, 12, 59, 14, 59, 603, 11,
-	59, 3, 59, 3, 59, 3, 60, 5, 60, 608, 10, 60, 3, 60, 3, 60, 3, 60, 5, 60,
-	613, 10, 60, 3, 60, 3, 60, 5, 60, 617, 10, 60, 3, 60, 5, 60, 620, 10, 60,
-	3, 60, 5, 60, 623, 10, 60, 3, 60, 3, 60, 3, 60, 5, 60, 628, 10, 60, 3,
-	60, 3, 60, 3, 60, 5, 60, 633, 10, 60, 3, 60, 3, 60, 3, 60, 3, 60, 3, 60,
-	3, 60, 5, 60, 641, 10, 60, 3, 60, 3, 60, 5, 60, 645, 10, 60, 3, 60, 5,
-	60, 648, 10, 60, 3, 60, 5, 60, 651, 10, 60, 3, 60, 3, 60, 3, 60, 3, 60,
-	3, 60, 3, 60, 5, 60, 659, 10, 60, 3, 60, 3, 60, 3, 60, 5, 60, 664, 10,
-	60, 3, 60, 3, 60, 3, 60, 3, 60, 5, 60, 670, 10, 60, 3, 60, 3, 60, 3, 60,
-	3, 60, 5, 60, 676, 10, 60, 3, 60, 3, 60, 3, 60, 3, 60, 3, 60, 3, 60, 3,
-	60, 3, 60, 5, 60, 686, 10, 60, 3, 61, 3, 61, 3, 61, 3, 61, 3, 61, 5, 61,
-	693, 10, 61, 3, 62, 5, 62, 696, 10, 62, 3, 62, 3, 62, 3, 63, 3, 63, 3,
-	63, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63,
-	3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 3,
-	63, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63,
-	3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 3, 63, 3,
-	63, 3, 63, 3, 63, 5, 63, 747, 10, 63, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64,
-	3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3,
-	64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64,
-	3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3,
-	64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64,
-	3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3,
-	64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64,
-	3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3, 64, 3,
-	64, 3, 64, 5, 64, 829, 10, 64, 3, 65, 3, 65, 3, 65, 3, 65, 3, 65, 3, 65,
-	3, 65, 3, 65, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3,
-	66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66,
-	3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3,
-	66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66,
-	3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3,
-	66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66,
-	3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3,
-	66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66,
-	3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3,
-	66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66,
-	3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3,
-	66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66,
-	3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3,
-	66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66,
-	3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3,
-	66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66,
-	3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3,
-	66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66, 3, 66,
-	3, 66, 3, 66, 3, 66, 3, 66, 5, 66, 1030, 10, 66, 3, 67, 3, 67, 3, 68, 3,
-	68, 3, 69, 3, 69, 3, 70, 3, 70, 5, 70, 1040, 10, 70, 3, 70, 7, 70, 1043,
-	10, 70, 12, 70, 14, 70, 1046, 11, 70, 3, 71, 3, 71, 5, 71, 1050, 10, 71,
-	3, 71, 7, 71, 1053, 10, 71, 12, 71, 14, 71, 1056, 11, 71, 3, 72, 3, 72,
-	3, 73, 3, 73, 3, 74, 3, 74, 3, 74, 3, 74, 3, 74, 3, 74, 3, 74, 3, 74, 3,
-	74, 3, 74, 3, 74, 3, 74, 3, 74, 3, 74, 3, 74, 3, 74, 3, 74, 3, 74, 3, 74,
-	6, 74, 1081, 10, 74, 13, 74, 14, 74, 1082, 3, 74, 3, 74, 5, 74, 1087, 10,
-	74, 3, 75, 3, 75, 3, 75, 3, 75, 5, 75, 1093, 10, 75, 3, 76, 3, 76, 3, 76,
-	3, 76, 3, 76, 3, 76, 5, 76, 1101, 10, 76, 3, 77, 3, 77, 3, 77, 3, 77, 3,
-	77, 3, 77, 5, 77, 1109, 10, 77, 3, 78, 3, 78, 5, 78, 1113, 10, 78, 3, 79,
-	3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 3,
-	79, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79,
-	3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 3,
-	79, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79,
-	3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 3,
-	79, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79,
-	3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 3, 79, 5, 79, 1185, 10, 79, 3, 80, 3,
-	80, 3, 80, 3, 80, 3, 80, 3, 80, 3, 80, 3, 80, 3, 80, 3, 80, 3, 80, 3, 80,
-	3, 80, 3, 80, 3, 80, 3, 80, 3, 80, 3, 80, 3, 80, 3, 80, 3, 80, 3, 80, 3,
-	80, 3, 80, 3, 80, 3, 80, 3, 80, 3, 80, 3, 80, 3, 80, 3, 80, 3, 80, 3, 80,
-	3, 80, 3, 80, 3, 80, 3, 80, 3, 80, 3, 80, 3, 80, 3, 80, 3, 80, 3, 80, 3,
-	80, 3, 80, 3, 80, 3, 80, 3, 80, 3, 80, 3, 80, 3, 80, 3, 80, 3, 80, 3, 80,
-	3, 80, 3, 80, 3, 80, 5, 80, 1244, 10, 80, 3, 81, 3, 81, 3, 81, 3, 81, 3,
-	81, 3, 81, 3, 81, 3, 81, 3, 81, 3, 81, 3, 81, 3, 81, 3, 81, 3, 81, 3, 81,
-	3, 81, 3, 81, 3, 81, 3, 81, 3, 81, 3, 81, 3, 81, 3, 81, 3, 81, 3, 81, 3,
-	81, 3, 81, 3, 81, 3, 81, 3, 81, 3, 81, 3, 81, 3, 81, 3, 81, 3, 81, 3, 81,
-	3, 81, 3, 81, 3, 81, 5, 81, 1285, 10, 81, 3, 82, 3, 82, 3, 82, 3, 82, 3,
-	82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 3, 82, 5, 82, 1299, 10, 82,
-	3, 83, 6, 83, 1302, 10, 83, 13, 83, 14, 83, 1303, 3, 83, 3, 83, 3, 84,
-	3, 84, 3, 84, 3, 84, 7, 84, 1312, 10, 84, 12, 84, 14, 84, 1315, 11, 84,
-	3, 84, 3, 84, 3, 85, 3, 85, 3, 85, 3, 85, 3, 85, 7, 85, 1324, 10, 85, 12,
-	85, 14, 85, 1327, 11, 85, 3, 85, 3, 85, 3, 85, 3, 85, 3, 85, 3, 1325, 2,
-	86, 3, 3, 5, 4, 7, 5, 9, 6, 11, 7, 13, 8, 15, 9, 17, 10, 19, 11, 21, 12,
-	23, 13, 25, 14, 27, 15, 29, 16, 31, 17, 33, 18, 35, 19, 37, 20, 39, 21,
-	41, 22, 43, 23, 45, 24, 47, 25, 49, 26, 51, 27, 53, 28, 55, 29, 57, 30,
-	59, 31, 61, 32, 63, 33, 65, 34, 67, 35, 69, 36, 71, 37, 73, 38, 75, 39,
-	77, 40, 79, 41, 81, 42, 83, 43, 85, 44, 87, 45, 89, 46, 91, 47, 93, 48,
-	95, 49, 97, 50, 99, 51, 101, 52, 103, 53, 105, 54, 107, 55, 109, 56, 111,
-	57, 113, 58, 115, 59, 117, 60, 119, 61, 121, 62, 123, 63, 125, 64, 127,
-	65, 129, 66, 131, 67, 133, 2, 135, 2, 137, 2, 139, 2, 141, 2, 143, 2, 145,
-	2, 147, 2, 149, 2, 151, 2, 153, 2, 155, 2, 157, 2, 159, 2, 161, 2, 163,
-	2, 165, 68, 167, 69, 169, 70, 3, 2, 11, 4, 2, 71, 71, 103, 103, 4, 2, 82,
-	82, 114, 114, 4, 2, 45, 45, 47, 47, 3, 2, 50, 59, 5, 2, 50, 59, 67, 72,
-	99, 104, 11, 2, 35, 35, 37, 41, 44, 45, 47, 60, 62, 92, 94, 94, 96, 124,
-	126, 126, 128, 128, 6, 2, 2, 33, 36, 36, 94, 94, 129, 129, 5, 2, 11, 12,
-	15, 15, 34, 34, 3, 2, 12, 12, 2, 1431, 2, 3, 3, 2, 2, 2, 2, 5, 3, 2, 2,
-	2, 2, 7, 3, 2, 2, 2, 2, 9, 3, 2, 2, 2, 2, 11, 3, 2, 2, 2, 2, 13, 3, 2,
-	2, 2, 2, 15, 3, 2, 2, 2, 2, 17, 3, 2, 2, 2, 2, 19, 3, 2, 2, 2, 2, 21, 3,
-	2, 2, 2, 2, 23, 3, 2, 2, 2, 2, 25, 3, 2, 2, 2, 2, 27, 3, 2, 2, 2, 2, 29,
-	3, 2, 2, 2, 2, 31, 3, 2, 2, 2, 2, 33, 3, 2, 2, 2, 2, 35, 3, 2, 2, 2, 2,
-	37, 3, 2, 2, 2, 2, 39, 3, 2, 2, 2, 2, 41, 3, 2, 2, 2, 2, 43, 3, 2, 2, 2,
-	2, 45, 3, 2, 2, 2, 2, 47, 3, 2, 2, 2, 2, 49, 3, 2, 2, 2, 2, 51, 3, 2, 2,
-	2, 2, 53, 3, 2, 2, 2, 2, 55, 3, 2, 2, 2, 2, 57, 3, 2, 2, 2, 2, 59, 3, 2,
-	2, 2, 2, 61, 3, 2, 2, 2, 2, 63, 3, 2, 2, 2, 2, 65, 3, 2, 2, 2, 2, 67, 3,
-	2, 2, 2, 2, 69, 3, 2, 2, 2, 2, 71, 3, 2, 2, 2, 2, 73, 3, 2, 2, 2, 2, 75,
-	3, 2, 2, 2, 2, 77, 3, 2, 2, 2, 2, 79, 3, 2, 2, 2, 2, 81, 3, 2, 2, 2, 2,
-	83, 3, 2, 2, 2, 2, 85, 3, 2, 2, 2, 2, 87, 3, 2, 2, 2, 2, 89, 3, 2, 2, 2,
-	2, 91, 3, 2, 2, 2, 2, 93, 3, 2, 2, 2, 2, 95, 3, 2, 2, 2, 2, 97, 3, 2, 2,
-	2, 2, 99, 3, 2, 2, 2, 2, 101, 3, 2, 2, 2, 2, 103, 3, 2, 2, 2, 2, 105, 3,
-	2, 2, 2, 2, 107, 3, 2, 2, 2, 2, 109, 3, 2, 2, 2, 2, 111, 3, 2, 2, 2, 2,
-	113, 3, 2, 2, 2, 2, 115, 3, 2, 2, 2, 2, 117, 3, 2, 2, 2, 2, 119, 3, 2,
-	2, 2, 2, 121, 3, 2, 2, 2, 2, 123, 3, 2, 2, 2, 2, 125, 3, 2, 2, 2, 2, 127,
-	3, 2, 2, 2, 2, 129, 3, 2, 2, 2, 2, 131, 3, 2, 2, 2, 2, 165, 3, 2, 2, 2,
-	2, 167, 3, 2, 2, 2, 2, 169, 3, 2, 2, 2, 3, 171, 3, 2, 2, 2, 5, 173, 3,
-	2, 2, 2, 7, 182, 3, 2, 2, 2, 9, 184, 3, 2, 2, 2, 11, 191, 3, 2, 2, 2, 13,
-	198, 3, 2, 2, 2, 15, 204, 3, 2, 2, 2, 17, 211, 3, 2, 2, 2, 19, 215, 3,
-	2, 2, 2, 21, 229, 3, 2, 2, 2, 23, 241, 3, 2, 2, 2, 25, 259, 3, 2, 2, 2,
-	27, 276, 3, 2, 2, 2, 29, 291, 3, 2, 2, 2, 31, 309, 3, 2, 2, 2, 33, 323,
-	3, 2, 2, 2, 35, 338, 3, 2, 2, 2, 37, 345, 3, 2, 2, 2, 39, 351, 3, 2, 2,
-	2, 41, 358, 3, 2, 2, 2, 43, 363, 3, 2, 2, 2, 45, 368, 3, 2, 2, 2, 47, 375,
-	3, 2, 2, 2, 49, 381, 3, 2, 2, 2, 51, 388, 3, 2, 2, 2, 53, 395, 3, 2, 2,
-	2, 55, 401, 3, 2, 2, 2, 57, 406, 3, 2, 2, 2, 59, 411, 3, 2, 2, 2, 61, 418,
-	3, 2, 2, 2, 63, 424, 3, 2, 2, 2, 65, 431, 3, 2, 2, 2, 67, 435, 3, 2, 2,
-	2, 69, 443, 3, 2, 2, 2, 71, 449, 3, 2, 2, 2, 73, 456, 3, 2, 2, 2, 75, 462,
-	3, 2, 2, 2, 77, 467, 3, 2, 2, 2, 79, 470, 3, 2, 2, 2, 81, 475, 3, 2, 2,
-	2, 83, 480, 3, 2, 2, 2, 85, 484, 3, 2, 2, 2, 87, 496, 3, 2, 2, 2, 89, 500,
-	3, 2, 2, 2, 91, 503, 3, 2, 2, 2, 93, 509, 3, 2, 2, 2, 95, 518, 3, 2, 2,
-	2, 97, 525, 3, 2, 2, 2, 99, 530, 3, 2, 2, 2, 101, 544, 3, 2, 2, 2, 103,
-	549, 3, 2, 2, 2, 105, 556, 3, 2, 2, 2, 107, 568, 3, 2, 2, 2, 109, 580,
-	3, 2, 2, 2, 111, 582, 3, 2, 2, 2, 113, 588, 3, 2, 2, 2, 115, 590, 3, 2,
-	2, 2, 117, 596, 3, 2, 2, 2, 119, 685, 3, 2, 2, 2, 121, 692, 3, 2, 2, 2,
-	123, 695, 3, 2, 2, 2, 125, 746, 3, 2, 2, 2, 127, 828, 3, 2, 2, 2, 129,
-	830, 3, 2, 2, 2, 131, 1029, 3, 2, 2, 2, 133, 1031, 3, 2, 2, 2, 135, 1033,
-	3, 2, 2, 2, 137, 1035, 3, 2, 2, 2, 139, 1037, 3, 2, 2, 2, 141, 1047, 3,
-	2, 2, 2, 143, 1057, 3, 2, 2, 2, 145, 1059, 3, 2, 2, 2, 147, 1086, 3, 2,
-	2, 2, 149, 1092, 3, 2, 2, 2, 151, 1100, 3, 2, 2, 2, 153, 1108, 3, 2, 2,
-	2, 155, 1112, 3, 2, 2, 2, 157, 1184, 3, 2, 2, 2, 159, 1243, 3, 2, 2, 2,
-	161, 1284, 3, 2, 2, 2, 163, 1298, 3, 2, 2, 2, 165, 1301, 3, 2, 2, 2, 167,
-	1307, 3, 2, 2, 2, 169, 1318, 3, 2, 2, 2, 171, 172, 7, 42, 2, 2, 172, 4,
-	3, 2, 2, 2, 173, 174, 7, 116, 2, 2, 174, 175, 7, 103, 2, 2, 175, 176, 7,
-	105, 2, 2, 176, 177, 7, 107, 2, 2, 177, 178, 7, 117, 2, 2, 178, 179, 7,
-	118, 2, 2, 179, 180, 7, 103, 2, 2, 180, 181, 7, 116, 2, 2, 181, 6, 3, 2,
-	2, 2, 182, 183, 7, 43, 2, 2, 183, 8, 3, 2, 2, 2, 184, 185, 7, 111, 2, 2,
-	185, 186, 7, 113, 2, 2, 186, 187, 7, 102, 2, 2, 187, 188, 7, 119, 2, 2,
-	188, 189, 7, 110, 2, 2, 189, 190, 7, 103, 2, 2, 190, 10, 3, 2, 2, 2, 191,
-	192, 7, 100, 2, 2, 192, 193, 7, 107, 2, 2, 193, 194, 7, 112, 2, 2, 194,
-	195, 7, 99, 2, 2, 195, 196, 7, 116, 2, 2, 196, 197, 7, 123, 2, 2, 197,
-	12, 3, 2, 2, 2, 198, 199, 7, 115, 2, 2, 199, 200, 7, 119, 2, 2, 200, 201,
-	7, 113, 2, 2, 201, 202, 7, 118, 2, 2, 202, 203, 7, 103, 2, 2, 203, 14,
-	3, 2, 2, 2, 204, 205, 7, 107, 2, 2, 205, 206, 7, 112, 2, 2, 206, 207, 7,
-	120, 2, 2, 207, 208, 7, 113, 2, 2, 208, 209, 7, 109, 2, 2, 209, 210, 7,
-	103, 2, 2, 210, 16, 3, 2, 2, 2, 211, 212, 7, 105, 2, 2, 212, 213, 7, 103,
-	2, 2, 213, 214, 7, 118, 2, 2, 214, 18, 3, 2, 2, 2, 215, 216, 7, 99, 2,
-	2, 216, 217, 7, 117, 2, 2, 217, 218, 7, 117, 2, 2, 218, 219, 7, 103, 2,
-	2, 219, 220, 7, 116, 2, 2, 220, 221, 7, 118, 2, 2, 221, 222, 7, 97, 2,
-	2, 222, 223, 7, 116, 2, 2, 223, 224, 7, 103, 2, 2, 224, 225, 7, 118, 2,
-	2, 225, 226, 7, 119, 2, 2, 226, 227, 7, 116, 2, 2, 227, 228, 7, 112, 2,
-	2, 228, 20, 3, 2, 2, 2, 229, 230, 7, 99, 2, 2, 230, 231, 7, 117, 2, 2,
-	231, 232, 7, 117, 2, 2, 232, 233, 7, 103, 2, 2, 233, 234, 7, 116, 2, 2,
-	234, 235, 7, 118, 2, 2, 235, 236, 7, 97, 2, 2, 236, 237, 7, 118, 2, 2,
-	237, 238, 7, 116, 2, 2, 238, 239, 7, 99, 2, 2, 239, 240, 7, 114, 2, 2,
-	240, 22, 3, 2, 2, 2, 241, 242, 7, 99, 2, 2, 242, 243, 7, 117, 2, 2, 243,
-	244, 7, 117, 2, 2, 244, 245, 7, 103, 2, 2, 245, 246, 7, 116, 2, 2, 246,
-	247, 7, 118, 2, 2, 247, 248, 7, 97, 2, 2, 248, 249, 7, 103, 2, 2, 249,
-	250, 7, 122, 2, 2, 250, 251, 7, 106, 2, 2, 251, 252, 7, 99, 2, 2, 252,
-	253, 7, 119, 2, 2, 253, 254, 7, 117, 2, 2, 254, 255, 7, 118, 2, 2, 255,
-	256, 7, 107, 2, 2, 256, 257, 7, 113, 2, 2, 257, 258, 7, 112, 2, 2, 258,
-	24, 3, 2, 2, 2, 259, 260, 7, 99, 2, 2, 260, 261, 7, 117, 2, 2, 261, 262,
-	7, 117, 2, 2, 262, 263, 7, 103, 2, 2, 263, 264, 7, 116, 2, 2, 264, 265,
-	7, 118, 2, 2, 265, 266, 7, 97, 2, 2, 266, 267, 7, 111, 2, 2, 267, 268,
-	7, 99, 2, 2, 268, 269, 7, 110, 2, 2, 269, 270, 7, 104, 2, 2, 270, 271,
-	7, 113, 2, 2, 271, 272, 7, 116, 2, 2, 272, 273, 7, 111, 2, 2, 273, 274,
-	7, 103, 2, 2, 274, 275, 7, 102, 2, 2, 275, 26, 3, 2, 2, 2, 276, 277, 7,
-	99, 2, 2, 277, 278, 7, 117, 2, 2, 278, 279, 7, 117, 2, 2, 279, 280, 7,
-	103, 2, 2, 280, 281, 7, 116, 2, 2, 281, 282, 7, 118, 2, 2, 282, 283, 7,
-	97, 2, 2, 283, 284, 7, 107, 2, 2, 284, 285, 7, 112, 2, 2, 285, 286, 7,
-	120, 2, 2, 286, 287, 7, 99, 2, 2, 287, 288, 7, 110, 2, 2, 288, 289, 7,
-	107, 2, 2, 289, 290, 7, 102, 2, 2, 290, 28, 3, 2, 2, 2, 291, 292, 7, 99,
-	2, 2, 292, 293, 7, 117, 2, 2, 293, 294, 7, 117, 2, 2, 294, 295, 7, 103,
-	2, 2, 295, 296, 7, 116, 2, 2, 296, 297, 7, 118, 2, 2, 297, 298, 7, 97,
-	2, 2, 298, 299, 7, 119, 2, 2, 299, 300, 7, 112, 2, 2, 300, 301, 7, 110,
-	2, 2, 301, 302, 7, 107, 2, 2, 302, 303, 7, 112, 2, 2, 303, 304, 7, 109,
-	2, 2, 304, 305, 7, 99, 2, 2, 305, 306, 7, 100, 2, 2, 306, 307, 7, 110,
-	2, 2, 307, 308, 7, 103, 2, 2, 308, 30, 3, 2, 2, 2, 309, 310, 7, 112, 2,
-	2, 310, 311, 7, 99, 2, 2, 311, 312, 7, 112, 2, 2, 312, 313, 7, 60, 2, 2,
-	313, 314, 7, 101, 2, 2, 314, 315, 7, 99, 2, 2, 315, 316, 7, 112, 2, 2,
-	316, 317, 7, 113, 2, 2, 317, 318, 7, 112, 2, 2, 318, 319, 7, 107, 2, 2,
-	319, 320, 7, 101, 2, 2, 320, 321, 7, 99, 2, 2, 321, 322, 7, 110, 2, 2,
-	322, 32, 3, 2, 2, 2, 323, 324, 7, 112, 2, 2, 324, 325, 7, 99, 2, 2, 325,
-	326, 7, 112, 2, 2, 326, 327, 7, 60, 2, 2, 327, 328, 7, 99, 2, 2, 328, 329,
-	7, 116, 2, 2, 329, 330, 7, 107, 2, 2, 330, 331, 7, 118, 2, 2, 331, 332,
-	7, 106, 2, 2, 332, 333, 7, 111, 2, 2, 333, 334, 7, 103, 2, 2, 334, 335,
-	7, 118, 2, 2, 335, 336, 7, 107, 2, 2, 336, 337, 7, 101, 2, 2, 337, 34,
-	3, 2, 2, 2, 338, 339, 7, 117, 2, 2, 339, 340, 7, 101, 2, 2, 340, 341, 7,
-	116, 2, 2, 341, 342, 7, 107, 2, 2, 342, 343, 7, 114, 2, 2, 343, 344, 7,
-	118, 2, 2, 344, 36, 3, 2, 2, 2, 345, 346, 7, 107, 2, 2, 346, 347, 7, 112,
-	2, 2, 347, 348, 7, 114, 2, 2, 348, 349, 7, 119, 2, 2, 349, 350, 7, 118,
-	2, 2, 350, 38, 3, 2, 2, 2, 351, 352, 7, 113, 2, 2, 352, 353, 7, 119, 2,
-	2, 353, 354, 7, 118, 2, 2, 354, 355, 7, 114, 2, 2, 355, 356, 7, 119, 2,
-	2, 356, 357, 7, 118, 2, 2, 357, 40, 3, 2, 2, 2, 358, 359, 7, 118, 2, 2,
-	359, 360, 7, 123, 2, 2, 360, 361, 7, 114, 2, 2, 361, 362, 7, 103, 2, 2,
-	362, 42, 3, 2, 2, 2, 363, 364, 7, 104, 2, 2, 364, 365, 7, 119, 2, 2, 365,
-	366, 7, 112, 2, 2, 366, 367, 7, 101, 2, 2, 367, 44, 3, 2, 2, 2, 368, 369,
-	7, 107, 2, 2, 369, 370, 7, 111, 2, 2, 370, 371, 7, 114, 2, 2, 371, 372,
-	7, 113, 2, 2, 372, 373, 7, 116, 2, 2, 373, 374, 7, 118, 2, 2, 374, 46,
-	3, 2, 2, 2, 375, 376, 7, 118, 2, 2, 376, 377, 7, 99, 2, 2, 377, 378, 7,
-	100, 2, 2, 378, 379, 7, 110, 2, 2, 379, 380, 7, 103, 2, 2, 380, 48, 3,
-	2, 2, 2, 381, 382, 7, 111, 2, 2, 382, 383, 7, 103, 2, 2, 383, 384, 7, 111,
-	2, 2, 384, 385, 7, 113, 2, 2, 385, 386, 7, 116, 2, 2, 386, 387, 7, 123,
-	2, 2, 387, 50, 3, 2, 2, 2, 388, 389, 7, 105, 2, 2, 389, 390, 7, 110, 2,
-	2, 390, 391, 7, 113, 2, 2, 391, 392, 7, 100, 2, 2, 392, 393, 7, 99, 2,
-	2, 393, 394, 7, 110, 2, 2, 394, 52, 3, 2, 2, 2, 395, 396, 7, 110, 2, 2,
-	396, 397, 7, 113, 2, 2, 397, 398, 7, 101, 2, 2, 398, 399, 7, 99, 2, 2,
-	399, 400, 7, 110, 2, 2, 400, 54, 3, 2, 2, 2, 401, 402, 7, 103, 2, 2, 402,
-	403, 7, 110, 2, 2, 403, 404, 7, 103, 2, 2, 404, 405, 7, 111, 2, 2, 405,
-	56, 3, 2, 2, 2, 406, 407, 7, 102, 2, 2, 407, 408, 7, 99, 2, 2, 408, 409,
-	7, 118, 2, 2, 409, 410, 7, 99, 2, 2, 410, 58, 3, 2, 2, 2, 411, 412, 7,
-	103, 2, 2, 412, 413, 7, 122, 2, 2, 413, 414, 7, 114, 2, 2, 414, 415, 7,
-	113, 2, 2, 415, 416, 7, 116, 2, 2, 416, 417, 7, 118, 2, 2, 417, 60, 3,
-	2, 2, 2, 418, 419, 7, 117, 2, 2, 419, 420, 7, 118, 2, 2, 420, 421, 7, 99,
-	2, 2, 421, 422, 7, 116, 2, 2, 422, 423, 7, 118, 2, 2, 423, 62, 3, 2, 2,
-	2, 424, 425, 7, 113, 2, 2, 425, 426, 7, 104, 2, 2, 426, 427, 7, 104, 2,
-	2, 427, 428, 7, 117, 2, 2, 428, 429, 7, 103, 2, 2, 429, 430, 7, 118, 2,
-	2, 430, 64, 3, 2, 2, 2, 431, 432, 7, 111, 2, 2, 432, 433, 7, 119, 2, 2,
-	433, 434, 7, 118, 2, 2, 434, 66, 3, 2, 2, 2, 435, 436, 7, 104, 2, 2, 436,
-	437, 7, 119, 2, 2, 437, 438, 7, 112, 2, 2, 438, 439, 7, 101, 2, 2, 439,
-	440, 7, 116, 2, 2, 440, 441, 7, 103, 2, 2, 441, 442, 7, 104, 2, 2, 442,
-	68, 3, 2, 2, 2, 443, 444, 7, 114, 2, 2, 444, 445, 7, 99, 2, 2, 445, 446,
-	7, 116, 2, 2, 446, 447, 7, 99, 2, 2, 447, 448, 7, 111, 2, 2, 448, 70, 3,
-	2, 2, 2, 449, 450, 7, 116, 2, 2, 450, 451, 7, 103, 2, 2, 451, 452, 7, 117,
-	2, 2, 452, 453, 7, 119, 2, 2, 453, 454, 7, 110, 2, 2, 454, 455, 7, 118,
-	2, 2, 455, 72, 3, 2, 2, 2, 456, 457, 7, 100, 2, 2, 457, 458, 7, 110, 2,
-	2, 458, 459, 7, 113, 2, 2, 459, 460, 7, 101, 2, 2, 460, 461, 7, 109, 2,
-	2, 461, 74, 3, 2, 2, 2, 462, 463, 7, 110, 2, 2, 463, 464, 7, 113, 2, 2,
-	464, 465, 7, 113, 2, 2, 465, 466, 7, 114, 2, 2, 466, 76, 3, 2, 2, 2, 467,
-	468, 7, 107, 2, 2, 468, 469, 7, 104, 2, 2, 469, 78, 3, 2, 2, 2, 470, 471,
-	7, 118, 2, 2, 471, 472, 7, 106, 2, 2, 472, 473, 7, 103, 2, 2, 473, 474,
-	7, 112, 2, 2, 474, 80, 3, 2, 2, 2, 475, 476, 7, 103, 2, 2, 476, 477, 7,
-	110, 2, 2, 477, 478, 7, 117, 2, 2, 478, 479, 7, 103, 2, 2, 479, 82, 3,
-	2, 2, 2, 480, 481, 7, 103, 2, 2, 481, 482, 7, 112, 2, 2, 482, 483, 7, 102,
-	2, 2, 483, 84, 3, 2, 2, 2, 484, 485, 7, 119, 2, 2, 485, 486, 7, 112, 2,
-	2, 486, 487, 7, 116, 2, 2, 487, 488, 7, 103, 2, 2, 488, 489, 7, 99, 2,
-	2, 489, 490, 7, 101, 2, 2, 490, 491, 7, 106, 2, 2, 491, 492, 7, 99, 2,
-	2, 492, 493, 7, 100, 2, 2, 493, 494, 7, 110, 2, 2, 494, 495, 7, 103, 2,
-	2, 495, 86, 3, 2, 2, 2, 496, 497, 7, 112, 2, 2, 497, 498, 7, 113, 2, 2,
-	498, 499, 7, 114, 2, 2, 499, 88, 3, 2, 2, 2, 500, 501, 7, 100, 2, 2, 501,
-	502, 7, 116, 2, 2, 502, 90, 3, 2, 2, 2, 503, 504, 7, 100, 2, 2, 504, 505,
-	7, 116, 2, 2, 505, 506, 7, 97, 2, 2, 506, 507, 7, 107, 2, 2, 507, 508,
-	7, 104, 2, 2, 508, 92, 3, 2, 2, 2, 509, 510, 7, 100, 2, 2, 510, 511, 7,
-	116, 2, 2, 511, 512, 7, 97, 2, 2, 512, 513, 7, 118, 2, 2, 513, 514, 7,
-	99, 2, 2, 514, 515, 7, 100, 2, 2, 515, 516, 7, 110, 2, 2, 516, 517, 7,
-	103, 2, 2, 517, 94, 3, 2, 2, 2, 518, 519, 7, 116, 2, 2, 519, 520, 7, 103,
-	2, 2, 520, 521, 7, 118, 2, 2, 521, 522, 7, 119, 2, 2, 522, 523, 7, 116,
-	2, 2, 523, 524, 7, 112, 2, 2, 524, 96, 3, 2, 2, 2, 525, 526, 7, 101, 2,
-	2, 526, 527, 7, 99, 2, 2, 527, 528, 7, 110, 2, 2, 528, 529, 7, 110, 2,
-	2, 529, 98, 3, 2, 2, 2, 530, 531, 7, 101, 2, 2, 531, 532, 7, 99, 2, 2,
-	532, 533, 7, 110, 2, 2, 533, 534, 7, 110, 2, 2, 534, 535, 7, 97, 2, 2,
-	535, 536, 7, 107, 2, 2, 536, 537, 7, 112, 2, 2, 537, 538, 7, 102, 2, 2,
-	538, 539, 7, 107, 2, 2, 539, 540, 7, 116, 2, 2, 540, 541, 7, 103, 2, 2,
-	541, 542, 7, 101, 2, 2, 542, 543, 7, 118, 2, 2, 543, 100, 3, 2, 2, 2, 544,
-	545, 7, 102, 2, 2, 545, 546, 7, 116, 2, 2, 546, 547, 7, 113, 2, 2, 547,
-	548, 7, 114, 2, 2, 548, 102, 3, 2, 2, 2, 549, 550, 7, 117, 2, 2, 550, 551,
-	7, 103, 2, 2, 551, 552, 7, 110, 2, 2, 552, 553, 7, 103, 2, 2, 553, 554,
-	7, 101, 2, 2, 554, 555, 7, 118, 2, 2, 555, 104, 3, 2, 2, 2, 556, 557, 7,
-	111, 2, 2, 557, 558, 7, 103, 2, 2, 558, 559, 7, 111, 2, 2, 559, 560, 7,
-	113, 2, 2, 560, 561, 7, 116, 2, 2, 561, 562, 7, 123, 2, 2, 562, 563, 7,
-	48, 2, 2, 563, 564, 7, 117, 2, 2, 564, 565, 7, 107, 2, 2, 565, 566, 7,
-	124, 2, 2, 566, 567, 7, 103, 2, 2, 567, 106, 3, 2, 2, 2, 568, 569, 7, 111,
-	2, 2, 569, 570, 7, 103, 2, 2, 570, 571, 7, 111, 2, 2, 571, 572, 7, 113,
-	2, 2, 572, 573, 7, 116, 2, 2, 573, 574, 7, 123, 2, 2, 574, 575, 7, 48,
-	2, 2, 575, 576, 7, 105, 2, 2, 576, 577, 7, 116, 2, 2, 577, 578, 7, 113,
-	2, 2, 578, 579, 7, 121, 2, 2, 579, 108, 3, 2, 2, 2, 580, 581, 7, 63, 2,
-	2, 581, 110, 3, 2, 2, 2, 582, 583, 7, 99, 2, 2, 583, 584, 7, 110, 2, 2,
-	584, 585, 7, 107, 2, 2, 585, 586, 7, 105, 2, 2, 586, 587, 7, 112, 2, 2,
-	587, 112, 3, 2, 2, 2, 588, 589, 5, 155, 78, 2, 589, 114, 3, 2, 2, 2, 590,
-	592, 7, 38, 2, 2, 591, 593, 5, 143, 72, 2, 592, 591, 3, 2, 2, 2, 593, 594,
-	3, 2, 2, 2, 594, 592, 3, 2, 2, 2, 594, 595, 3, 2, 2, 2, 595, 116, 3, 2,
-	2, 2, 596, 601, 7, 36, 2, 2, 597, 600, 5, 145, 73, 2, 598, 600, 5, 147,
-	74, 2, 599, 597, 3, 2, 2, 2, 599, 598, 3, 2, 2, 2, 600, 603, 3, 2, 2, 2,
-	601, 599, 3, 2, 2, 2, 601, 602, 3, 2, 2, 2, 602, 604, 3, 2, 2, 2, 603,
-	601, 3, 2, 2, 2, 604, 605, 7, 36, 2, 2, 605, 118, 3, 2, 2, 2, 606, 608,
-	5, 133, 67, 2, 607, 606, 3, 2, 2, 2, 607, 608, 3, 2, 2, 2, 608, 609, 3,
-	2, 2, 2, 609, 610, 5, 139, 70, 2, 610, 612, 7, 48, 2, 2, 611, 613, 5, 139,
-	70, 2, 612, 611, 3, 2, 2, 2, 612, 613, 3, 2, 2, 2, 613, 619, 3, 2, 2, 2,
-	614, 616, 9, 2, 2, 2, 615, 617, 5, 133, 67, 2, 616, 615, 3, 2, 2, 2, 616,
-	617, 3, 2, 2, 2, 617, 618, 3, 2, 2, 2, 618, 620, 5, 139, 70, 2, 619, 614,
-	3, 2, 2, 2, 619, 620, 3, 2, 2, 2, 620, 686, 3, 2, 2, 2, 621, 623, 5, 133,
-	67, 2, 622, 621, 3, 2, 2, 2, 622, 623, 3, 2, 2, 2, 623, 624, 3, 2, 2, 2,
-	624, 625, 5, 139, 70, 2, 625, 627, 9, 2, 2, 2, 626, 628, 5, 133, 67, 2,
-	627, 626, 3, 2, 2, 2, 627, 628, 3, 2, 2, 2, 628, 629, 3, 2, 2, 2, 629,
-	630, 5, 139, 70, 2, 630, 686, 3, 2, 2, 2, 631, 633, 5, 133, 67, 2, 632,
-	631, 3, 2, 2, 2, 632, 633, 3, 2, 2, 2, 633, 634, 3, 2, 2, 2, 634, 635,
-	7, 50, 2, 2, 635, 636, 7, 122, 2, 2, 636, 637, 3, 2, 2, 2, 637, 638, 5,
-	141, 71, 2, 638, 640, 7, 48, 2, 2, 639, 641, 5, 141, 71, 2, 640, 639, 3,
-	2, 2, 2, 640, 641, 3, 2, 2, 2, 641, 647, 3, 2, 2, 2, 642, 644, 9, 3, 2,
-	2, 643, 645, 5, 133, 67, 2, 644, 643, 3, 2, 2, 2, 644, 645, 3, 2, 2, 2,
-	645, 646, 3, 2, 2, 2, 646, 648, 5, 139, 70, 2, 647, 642, 3, 2, 2, 2, 647,
-	648, 3, 2, 2, 2, 648, 686, 3, 2, 2, 2, 649, 651, 5, 133, 67, 2, 650, 649,
-	3, 2, 2, 2, 650, 651, 3, 2, 2, 2, 651, 652, 3, 2, 2, 2, 652, 653, 7, 50,
-	2, 2, 653, 654, 7, 122, 2, 2, 654, 655, 3, 2, 2, 2, 655, 656, 5, 141, 71,
-	2, 656, 658, 9, 3, 2, 2, 657, 659, 5, 133, 67, 2, 658, 657, 3, 2, 2, 2,
-	658, 659, 3, 2, 2, 2, 659, 660, 3, 2, 2, 2, 660, 661, 5, 139, 70, 2, 661,
-	686, 3, 2, 2, 2, 662, 664, 5, 133, 67, 2, 663, 662, 3, 2, 2, 2, 663, 664,
-	3, 2, 2, 2, 664, 665, 3, 2, 2, 2, 665, 666, 7, 107, 2, 2, 666, 667, 7,
-	112, 2, 2, 667, 686, 7, 104, 2, 2, 668, 670, 5, 133, 67, 2, 669, 668, 3,
-	2, 2, 2, 669, 670, 3, 2, 2, 2, 670, 671, 3, 2, 2, 2, 671, 672, 7, 112,
-	2, 2, 672, 673, 7, 99, 2, 2, 673, 686, 7, 112, 2, 2, 674, 676, 5, 133,
-	67, 2, 675, 674, 3, 2, 2, 2, 675, 676, 3, 2, 2, 2, 676, 677, 3, 2, 2, 2,
-	677, 678, 7, 112, 2, 2, 678, 679, 7, 99, 2, 2, 679, 680, 7, 112, 2, 2,
-	680, 681, 7, 60, 2, 2, 681, 682, 7, 50, 2, 2, 682, 683, 7, 122, 2, 2, 683,
-	684, 3, 2, 2, 2, 684, 686, 5, 141, 71, 2, 685, 607, 3, 2, 2, 2, 685, 622,
-	3, 2, 2, 2, 685, 632, 3, 2, 2, 2, 685, 650, 3, 2, 2, 2, 685, 663, 3, 2,
-	2, 2, 685, 669, 3, 2, 2, 2, 685, 675, 3, 2, 2, 2, 686, 120, 3, 2, 2, 2,
-	687, 693, 5, 139, 70, 2, 688, 689, 7, 50, 2, 2, 689, 690, 7, 122, 2, 2,
-	690, 691, 3, 2, 2, 2, 691, 693, 5, 141, 71, 2, 692, 687, 3, 2, 2, 2, 692,
-	688, 3, 2, 2, 2, 693, 122, 3, 2, 2, 2, 694, 696, 5, 133, 67, 2, 695, 694,
-	3, 2, 2, 2, 695, 696, 3, 2, 2, 2, 696, 697, 3, 2, 2, 2, 697, 698, 5, 121,
-	61, 2, 698, 124, 3, 2, 2, 2, 699, 700, 7, 110, 2, 2, 700, 701, 7, 113,
-	2, 2, 701, 702, 7, 101, 2, 2, 702, 703, 7, 99, 2, 2, 703, 704, 7, 110,
-	2, 2, 704, 705, 7, 48, 2, 2, 705, 706, 7, 105, 2, 2, 706, 707, 7, 103,
-	2, 2, 707, 747, 7, 118, 2, 2, 708, 709, 7, 110, 2, 2, 709, 710, 7, 113,
-	2, 2, 710, 711, 7, 101, 2, 2, 711, 712, 7, 99, 2, 2, 712, 713, 7, 110,
-	2, 2, 713, 714, 7, 48, 2, 2, 714, 715, 7, 117, 2, 2, 715, 716, 7, 103,
-	2, 2, 716, 747, 7, 118, 2, 2, 717, 718, 7, 110, 2, 2, 718, 719, 7, 113,
-	2, 2, 719, 720, 7, 101, 2, 2, 720, 721, 7, 99, 2, 2, 721, 722, 7, 110,
-	2, 2, 722, 723, 7, 48, 2, 2, 723, 724, 7, 118, 2, 2, 724, 725, 7, 103,
-	2, 2, 725, 747, 7, 103, 2, 2, 726, 727, 7, 105, 2, 2, 727, 728, 7, 110,
-	2, 2, 728, 729, 7, 113, 2, 2, 729, 730, 7, 100, 2, 2, 730, 731, 7, 99,
-	2, 2, 731, 732, 7, 110, 2, 2, 732, 733, 7, 48, 2, 2, 733, 734, 7, 105,
-	2, 2, 734, 735, 7, 103, 2, 2, 735, 747, 7, 118, 2, 2, 736, 737, 7, 105,
-	2, 2, 737, 738, 7, 110, 2, 2, 738, 739, 7, 113, 2, 2, 739, 740, 7, 100,
-	2, 2, 740, 741, 7, 99, 2, 2, 741, 742, 7, 110, 2, 2, 742, 743, 7, 48, 2,
-	2, 743, 744, 7, 117, 2, 2, 744, 745, 7, 103, 2, 2, 745, 747, 7, 118, 2,
-	2, 746, 699, 3, 2, 2, 2, 746, 708, 3, 2, 2, 2, 746, 717, 3, 2, 2, 2, 746,
-	726, 3, 2, 2, 2, 746, 736, 3, 2, 2, 2, 747, 126, 3, 2, 2, 2, 748, 749,
-	5, 155, 78, 2, 749, 750, 7, 48, 2, 2, 750, 751, 7, 110, 2, 2, 751, 752,
-	7, 113, 2, 2, 752, 753, 7, 99, 2, 2, 753, 754, 7, 102, 2, 2, 754, 829,
-	3, 2, 2, 2, 755, 756, 5, 151, 76, 2, 756, 757, 7, 48, 2, 2, 757, 758, 7,
-	110, 2, 2, 758, 759, 7, 113, 2, 2, 759, 760, 7, 99, 2, 2, 760, 761, 7,
-	102, 2, 2, 761, 762, 7, 58, 2, 2, 762, 763, 3, 2, 2, 2, 763, 764, 5, 149,
-	75, 2, 764, 829, 3, 2, 2, 2, 765, 766, 5, 151, 76, 2, 766, 767, 7, 48,
-	2, 2, 767, 768, 7, 110, 2, 2, 768, 769, 7, 113, 2, 2, 769, 770, 7, 99,
-	2, 2, 770, 771, 7, 102, 2, 2, 771, 772, 7, 51, 2, 2, 772, 773, 7, 56, 2,
-	2, 773, 774, 3, 2, 2, 2, 774, 775, 5, 149, 75, 2, 775, 829, 3, 2, 2, 2,
-	776, 777, 7, 107, 2, 2, 777, 778, 7, 56, 2, 2, 778, 779, 7, 54, 2, 2, 779,
-	780, 3, 2, 2, 2, 780, 781, 7, 48, 2, 2, 781, 782, 7, 110, 2, 2, 782, 783,
-	7, 113, 2, 2, 783, 784, 7, 99, 2, 2, 784, 785, 7, 102, 2, 2, 785, 786,
-	7, 53, 2, 2, 786, 787, 7, 52, 2, 2, 787, 788, 3, 2, 2, 2, 788, 829, 5,
-	149, 75, 2, 789, 790, 5, 155, 78, 2, 790, 791, 7, 48, 2, 2, 791, 792, 7,
-	117, 2, 2, 792, 793, 7, 118, 2, 2, 793, 794, 7, 113, 2, 2, 794, 795, 7,
-	116, 2, 2, 795, 796, 7, 103, 2, 2, 796, 829, 3, 2, 2, 2, 797, 798, 5, 151,
-	76, 2, 798, 799, 7, 48, 2, 2, 799, 800, 7, 117, 2, 2, 800, 801, 7, 118,
-	2, 2, 801, 802, 7, 113, 2, 2, 802, 803, 7, 116, 2, 2, 803, 804, 7, 103,
-	2, 2, 804, 805, 7, 58, 2, 2, 805, 829, 3, 2, 2, 2, 806, 807, 5, 151, 76,
-	2, 807, 808, 7, 48, 2, 2, 808, 809, 7, 117, 2, 2, 809, 810, 7, 118, 2,
-	2, 810, 811, 7, 113, 2, 2, 811, 812, 7, 116, 2, 2, 812, 813, 7, 103, 2,
-	2, 813, 814, 7, 51, 2, 2, 814, 815, 7, 56, 2, 2, 815, 829, 3, 2, 2, 2,
-	816, 817, 7, 107, 2, 2, 817, 818, 7, 56, 2, 2, 818, 819, 7, 54, 2, 2, 819,
-	820, 3, 2, 2, 2, 820, 821, 7, 48, 2, 2, 821, 822, 7, 117, 2, 2, 822, 823,
-	7, 118, 2, 2, 823, 824, 7, 113, 2, 2, 824, 825, 7, 116, 2, 2, 825, 826,
-	7, 103, 2, 2, 826, 827, 7, 53, 2, 2, 827, 829, 7, 52, 2, 2, 828, 748, 3,
-	2, 2, 2, 828, 755, 3, 2, 2, 2, 828, 765, 3, 2, 2, 2, 828, 776, 3, 2, 2,
-	2, 828, 789, 3, 2, 2, 2, 828, 797, 3, 2, 2, 2, 828, 806, 3, 2, 2, 2, 828,
-	816, 3, 2, 2, 2, 829, 128, 3, 2, 2, 2, 830, 831, 5, 155, 78, 2, 831, 832,
-	7, 48, 2, 2, 832, 833, 7, 101, 2, 2, 833, 834, 7, 113, 2, 2, 834, 835,
-	7, 112, 2, 2, 835, 836, 7, 117, 2, 2, 836, 837, 7, 118, 2, 2, 837, 130,
-	3, 2, 2, 2, 838, 839, 5, 151, 76, 2, 839, 840, 7, 48, 2, 2, 840, 841, 5,
-	157, 79, 2, 841, 1030, 3, 2, 2, 2, 842, 843, 5, 151, 76, 2, 843, 844, 7,
-	48, 2, 2, 844, 845, 5, 161, 81, 2, 845, 1030, 3, 2, 2, 2, 846, 847, 5,
-	153, 77, 2, 847, 848, 7, 48, 2, 2, 848, 849, 5, 159, 80, 2, 849, 1030,
-	3, 2, 2, 2, 850, 851, 5, 153, 77, 2, 851, 852, 7, 48, 2, 2, 852, 853, 5,
-	163, 82, 2, 853, 1030, 3, 2, 2, 2, 854, 855, 5, 151, 76, 2, 855, 856, 7,
-	48, 2, 2, 856, 857, 7, 118, 2, 2, 857, 858, 7, 116, 2, 2, 858, 859, 7,
-	119, 2, 2, 859, 860, 7, 112, 2, 2, 860, 861, 7, 101, 2, 2, 861, 862, 7,
-	97, 2, 2, 862, 863, 3, 2, 2, 2, 863, 864, 5, 153, 77, 2, 864, 865, 5, 149,
-	75, 2, 865, 1030, 3, 2, 2, 2, 866, 867, 5, 153, 77, 2, 867, 868, 7, 48,
-	2, 2, 868, 869, 7, 101, 2, 2, 869, 870, 7, 113, 2, 2, 870, 871, 7, 112,
-	2, 2, 871, 872, 7, 120, 2, 2, 872, 873, 7, 103, 2, 2, 873, 874, 7, 116,
-	2, 2, 874, 875, 7, 118, 2, 2, 875, 876, 7, 97, 2, 2, 876, 877, 3, 2, 2,
-	2, 877, 878, 5, 151, 76, 2, 878, 879, 5, 149, 75, 2, 879, 1030, 3, 2, 2,
-	2, 880, 881, 7, 107, 2, 2, 881, 882, 7, 53, 2, 2, 882, 883, 7, 52, 2, 2,
-	883, 884, 7, 48, 2, 2, 884, 885, 7, 121, 2, 2, 885, 886, 7, 116, 2, 2,
-	886, 887, 7, 99, 2, 2, 887, 888, 7, 114, 2, 2, 888, 889, 7, 97, 2, 2, 889,
-	890, 7, 107, 2, 2, 890, 891, 7, 56, 2, 2, 891, 1030, 7, 54, 2, 2, 892,
-	893, 7, 107, 2, 2, 893, 894, 7, 56, 2, 2, 894, 895, 7, 54, 2, 2, 895, 896,
-	7, 48, 2, 2, 896, 897, 7, 103, 2, 2, 897, 898, 7, 122, 2, 2, 898, 899,
-	7, 118, 2, 2, 899, 900, 7, 103, 2, 2, 900, 901, 7, 112, 2, 2, 901, 902,
-	7, 102, 2, 2, 902, 903, 7, 97, 2, 2, 903, 904, 7, 107, 2, 2, 904, 905,
-	7, 53, 2, 2, 905, 906, 7, 52, 2, 2, 906, 907, 7, 97, 2, 2, 907, 1030, 7,
-	117, 2, 2, 908, 909, 7, 107, 2, 2, 909, 910, 7, 56, 2, 2, 910, 911, 7,
-	54, 2, 2, 911, 912, 7, 48, 2, 2, 912, 913, 7, 103, 2, 2, 913, 914, 7, 122,
-	2, 2, 914, 915, 7, 118, 2, 2, 915, 916, 7, 103, 2, 2, 916, 917, 7, 112,
-	2, 2, 917, 918, 7, 102, 2, 2, 918, 919, 7, 97, 2, 2, 919, 920, 7, 107,
-	2, 2, 920, 921, 7, 53, 2, 2, 921, 922, 7, 52, 2, 2, 922, 923, 7, 97, 2,
-	2, 923, 1030, 7, 119, 2, 2, 924, 925, 7, 104, 2, 2, 925, 926, 7, 53, 2,
-	2, 926, 927, 7, 52, 2, 2, 927, 928, 7, 48, 2, 2, 928, 929, 7, 102, 2, 2,
-	929, 930, 7, 103, 2, 2, 930, 931, 7, 111, 2, 2, 931, 932, 7, 113, 2, 2,
-	932, 933, 7, 118, 2, 2, 933, 934, 7, 103, 2, 2, 934, 935, 7, 97, 2, 2,
-	935, 936, 7, 104, 2, 2, 936, 937, 7, 56, 2, 2, 937, 1030, 7, 54, 2, 2,
-	938, 939, 7, 104, 2, 2, 939, 940, 7, 56, 2, 2, 940, 941, 7, 54, 2, 2, 941,
-	942, 7, 48, 2, 2, 942, 943, 7, 114, 2, 2, 943, 944, 7, 116, 2, 2, 944,
-	945, 7, 113, 2, 2, 945, 946, 7, 111, 2, 2, 946, 947, 7, 113, 2, 2, 947,
-	948, 7, 118, 2, 2, 948, 949, 7, 103, 2, 2, 949, 950, 7, 97, 2, 2, 950,
-	951, 7, 104, 2, 2, 951, 952, 7, 53, 2, 2, 952, 1030, 7, 52, 2, 2, 953,
-	954, 7, 107, 2, 2, 954, 955, 7, 53, 2, 2, 955, 956, 7, 52, 2, 2, 956, 957,
-	7, 48, 2, 2, 957, 958, 7, 116, 2, 2, 958, 959, 7, 103, 2, 2, 959, 960,
-	7, 107, 2, 2, 960, 961, 7, 112, 2, 2, 961, 962, 7, 118, 2, 2, 962, 963,
-	7, 103, 2, 2, 963, 964, 7, 116, 2, 2, 964, 965, 7, 114, 2, 2, 965, 966,
-	7, 116, 2, 2, 966, 967, 7, 103, 2, 2, 967, 968, 7, 118, 2, 2, 968, 969,
-	7, 97, 2, 2, 969, 970, 7, 104, 2, 2, 970, 971, 7, 53, 2, 2, 971, 1030,
-	7, 52, 2, 2, 972, 973, 7, 107, 2, 2, 973, 974, 7, 56, 2, 2, 974, 975, 7,
-	54, 2, 2, 975, 976, 7, 48, 2, 2, 976, 977, 7, 116, 2, 2, 977, 978, 7, 103,
-	2, 2, 978, 979, 7, 107, 2, 2, 979, 980, 7, 112, 2, 2, 980, 981, 7, 118,
-	2, 2, 981, 982, 7, 103, 2, 2, 982, 983, 7, 116, 2, 2, 983, 984, 7, 114,
-	2, 2, 984, 985, 7, 116, 2, 2, 985, 986, 7, 103, 2, 2, 986, 987, 7, 118,
-	2, 2, 987, 988, 7, 97, 2, 2, 988, 989, 7, 104, 2, 2, 989, 990, 7, 56, 2,
-	2, 990, 1030, 7, 54, 2, 2, 991, 992, 7, 104, 2, 2, 992, 993, 7, 53, 2,
-	2, 993, 994, 7, 52, 2, 2, 994, 995, 7, 48, 2, 2, 995, 996, 7, 116, 2, 2,
-	996, 997, 7, 103, 2, 2, 997, 998, 7, 107, 2, 2, 998, 999, 7, 112, 2, 2,
-	999, 1000, 7, 118, 2, 2, 1000, 1001, 7, 103, 2, 2, 1001, 1002, 7, 116,
-	2, 2, 1002, 1003, 7, 114, 2, 2, 1003, 1004, 7, 116, 2, 2, 1004, 1005, 7,
-	103, 2, 2, 1005, 1006, 7, 118, 2, 2, 1006, 1007, 7, 97, 2, 2, 1007, 1008,
-	7, 107, 2, 2, 1008, 1009, 7, 53, 2, 2, 1009, 1030, 7, 52, 2, 2, 1010, 1011,
-	7, 104, 2, 2, 1011, 1012, 7, 56, 2, 2, 1012, 1013, 7, 54, 2, 2, 1013, 1014,
-	7, 48, 2, 2, 1014, 1015, 7, 116, 2, 2, 1015, 1016, 7, 103, 2, 2, 1016,
-	1017, 7, 107, 2, 2, 1017, 1018, 7, 112, 2, 2, 1018, 1019, 7, 118, 2, 2,
-	1019, 1020, 7, 103, 2, 2, 1020, 1021, 7, 116, 2, 2, 1021, 1022, 7, 114,
-	2, 2, 1022, 1023, 7, 116, 2, 2, 1023, 1024, 7, 103, 2, 2, 1024, 1025, 7,
-	118, 2, 2, 1025, 1026, 7, 97, 2, 2, 1026, 1027, 7, 107, 2, 2, 1027, 1028,
-	7, 56, 2, 2, 1028, 1030, 7, 54, 2, 2, 1029, 838, 3, 2, 2, 2, 1029, 842,
-	3, 2, 2, 2, 1029, 846, 3, 2, 2, 2, 1029, 850, 3, 2, 2, 2, 1029, 854, 3,
-	2, 2, 2, 1029, 866, 3, 2, 2, 2, 1029, 880, 3, 2, 2, 2, 1029, 892, 3, 2,
-	2, 2, 1029, 908, 3, 2, 2, 2, 1029, 924, 3, 2, 2, 2, 1029, 938, 3, 2, 2,
-	2, 1029, 953, 3, 2, 2, 2, 1029, 972, 3, 2, 2, 2, 1029, 991, 3, 2, 2, 2,
-	1029, 1010, 3, 2, 2, 2, 1030, 132, 3, 2, 2, 2, 1031, 1032, 9, 4, 2, 2,
-	1032, 134, 3, 2, 2, 2, 1033, 1034, 9, 5, 2, 2, 1034, 136, 3, 2, 2, 2, 1035,
-	1036, 9, 6, 2, 2, 1036, 138, 3, 2, 2, 2, 1037, 1044, 5, 135, 68, 2, 1038,
-	1040, 7, 97, 2, 2, 1039, 1038, 3, 2, 2, 2, 1039, 1040, 3, 2, 2, 2, 1040,
-	1041, 3, 2, 2, 2, 1041, 1043, 5, 135, 68, 2, 1042, 1039, 3, 2, 2, 2, 1043,
-	1046, 3, 2, 2, 2, 1044, 1042, 3, 2, 2, 2, 1044, 1045, 3, 2, 2, 2, 1045,
-	140, 3, 2, 2, 2, 1046, 1044, 3, 2, 2, 2, 1047, 1054, 5, 137, 69, 2, 1048,
-	1050, 7, 97, 2, 2, 1049, 1048, 3, 2, 2, 2, 1049, 1050, 3, 2, 2, 2, 1050,
-	1051, 3, 2, 2, 2, 1051, 1053, 5, 137, 69, 2, 1052, 1049, 3, 2, 2, 2, 1053,
-	1056, 3, 2, 2, 2, 1054, 1052, 3, 2, 2, 2, 1054, 1055, 3, 2, 2, 2, 1055,
-	142, 3, 2, 2, 2, 1056, 1054, 3, 2, 2, 2, 1057, 1058, 9, 7, 2, 2, 1058,
-	144, 3, 2, 2, 2, 1059, 1060, 10, 8, 2, 2, 1060, 146, 3, 2, 2, 2, 1061,
-	1062, 7, 94, 2, 2, 1062, 1087, 7, 118, 2, 2, 1063, 1064, 7, 94, 2, 2, 1064,
-	1087, 7, 112, 2, 2, 1065, 1066, 7, 94, 2, 2, 1066, 1087, 7, 116, 2, 2,
-	1067, 1068, 7, 94, 2, 2, 1068, 1087, 7, 36, 2, 2, 1069, 1070, 7, 94, 2,
-	2, 1070, 1087, 7, 94, 2, 2, 1071, 1072, 7, 94, 2, 2, 1072, 1073, 5, 137,
-	69, 2, 1073, 1074, 5, 137, 69, 2, 1074, 1087, 3, 2, 2, 2, 1075, 1076, 7,
-	94, 2, 2, 1076, 1077, 7, 119, 2, 2, 1077, 1078, 7, 125, 2, 2, 1078, 1080,
-	3, 2, 2, 2, 1079, 1081, 5, 137, 69, 2, 1080, 1079, 3, 2, 2, 2, 1081, 1082,
-	3, 2, 2, 2, 1082, 1080, 3, 2, 2, 2, 1082, 1083, 3, 2, 2, 2, 1083, 1084,
-	3, 2, 2, 2, 1084, 1085, 7, 127, 2, 2, 1085, 1087, 3, 2, 2, 2, 1086, 1061,
-	3, 2, 2, 2, 1086, 1063, 3, 2, 2, 2, 1086, 1065, 3, 2, 2, 2, 1086, 1067,
-	3, 2, 2, 2, 1086, 1069, 3, 2, 2, 2, 1086, 1071, 3, 2, 2, 2, 1086, 1075,
-	3, 2, 2, 2, 1087, 148, 3, 2, 2, 2, 1088, 1089, 7, 97, 2, 2, 1089, 1093,
-	7, 117, 2, 2, 1090, 1091, 7, 97, 2, 2, 1091, 1093, 7, 119, 2, 2, 1092,
-	1088, 3, 2, 2, 2, 1092, 1090, 3, 2, 2, 2, 1093, 150, 3, 2, 2, 2, 1094,
-	1095, 7, 107, 2, 2, 1095, 1096, 7, 53, 2, 2, 1096, 1101, 7, 52, 2, 2, 1097,
-	1098, 7, 107, 2, 2, 1098, 1099, 7, 56, 2, 2, 1099, 1101, 7, 54, 2, 2, 1100,
-	1094, 3, 2, 2, 2, 1100, 1097, 3, 2, 2, 2, 1101, 152, 3, 2, 2, 2, 1102,
-	1103, 7, 104, 2, 2, 1103, 1104, 7, 53, 2, 2, 1104, 1109, 7, 52, 2, 2, 1105,
-	1106, 7, 104, 2, 2, 1106, 1107, 7, 56, 2, 2, 1107, 1109, 7, 54, 2, 2, 1108,
-	1102, 3, 2, 2, 2, 1108, 1105, 3, 2, 2, 2, 1109, 154, 3, 2, 2, 2, 1110,
-	1113, 5, 151, 76, 2, 1111, 1113, 5, 153, 77, 2, 1112, 1110, 3, 2, 2, 2,
-	1112, 1111, 3, 2, 2, 2, 1113, 156, 3, 2, 2, 2, 1114, 1115, 7, 101, 2, 2,
-	1115, 1116, 7, 110, 2, 2, 1116, 1185, 7, 124, 2, 2, 1117, 1118, 7, 101,
-	2, 2, 1118, 1119, 7, 118, 2, 2, 1119, 1185, 7, 124, 2, 2, 1120, 1121, 7,
-	114, 2, 2, 1121, 1122, 7, 113, 2, 2, 1122, 1123, 7, 114, 2, 2, 1123, 1124,
-	7, 101, 2, 2, 1124, 1125, 7, 112, 2, 2, 1125, 1185, 7, 118, 2, 2, 1126,
-	1127, 7, 99, 2, 2, 1127, 1128, 7, 102, 2, 2, 1128, 1185, 7, 102, 2, 2,
-	1129, 1130, 7, 117, 2, 2, 1130, 1131, 7, 119, 2, 2, 1131, 1185, 7, 100,
-	2, 2, 1132, 1133, 7, 111, 2, 2, 1133, 1134, 7, 119, 2, 2, 1134, 1185, 7,
-	110, 2, 2, 1135, 1136, 7, 102, 2, 2, 1136, 1137, 7, 107, 2, 2, 1137, 1138,
-	7, 120, 2, 2, 1138, 1139, 7, 97, 2, 2, 1139, 1185, 7, 117, 2, 2, 1140,
-	1141, 7, 102, 2, 2, 1141, 1142, 7, 107, 2, 2, 1142, 1143, 7, 120, 2, 2,
-	1143, 1144, 7, 97, 2, 2, 1144, 1185, 7, 119, 2, 2, 1145, 1146, 7, 116,
-	2, 2, 1146, 1147, 7, 103, 2, 2, 1147, 1148, 7, 111, 2, 2, 1148, 1149, 7,
-	97, 2, 2, 1149, 1185, 7, 117, 2, 2, 1150, 1151, 7, 116, 2, 2, 1151, 1152,
-	7, 103, 2, 2, 1152, 1153, 7, 111, 2, 2, 1153, 1154, 7, 97, 2, 2, 1154,
-	1185, 7, 119, 2, 2, 1155, 1156, 7, 99, 2, 2, 1156, 1157, 7, 112, 2, 2,
-	1157, 1185, 7, 102, 2, 2, 1158, 1159, 7, 113, 2, 2, 1159, 1185, 7, 116,
-	2, 2, 1160, 1161, 7, 122, 2, 2, 1161, 1162, 7, 113, 2, 2, 1162, 1185, 7,
-	116, 2, 2, 1163, 1164, 7, 117, 2, 2, 1164, 1165, 7, 106, 2, 2, 1165, 1185,
-	7, 110, 2, 2, 1166, 1167, 7, 117, 2, 2, 1167, 1168, 7, 106, 2, 2, 1168,
-	1169, 7, 116, 2, 2, 1169, 1170, 7, 97, 2, 2, 1170, 1185, 7, 117, 2, 2,
-	1171, 1172, 7, 117, 2, 2, 1172, 1173, 7, 106, 2, 2, 1173, 1174, 7, 116,
-	2, 2, 1174, 1175, 7, 97, 2, 2, 1175, 1185, 7, 119, 2, 2, 1176, 1177, 7,
-	116, 2, 2, 1177, 1178, 7, 113, 2, 2, 1178, 1179, 7, 118, 2, 2, 1179, 1185,
-	7, 110, 2, 2, 1180, 1181, 7, 116, 2, 2, 1181, 1182, 7, 113, 2, 2, 1182,
-	1183, 7, 118, 2, 2, 1183, 1185, 7, 116, 2, 2, 1184, 1114, 3, 2, 2, 2, 1184,
-	1117, 3, 2, 2, 2, 1184, 1120, 3, 2, 2, 2, 1184, 1126, 3, 2, 2, 2, 1184,
-	1129, 3, 2, 2, 2, 1184, 1132, 3, 2, 2, 2, 1184, 1135, 3, 2, 2, 2, 1184,
-	1140, 3, 2, 2, 2, 1184, 1145, 3, 2, 2, 2, 1184, 1150, 3, 2, 2, 2, 1184,
-	1155, 3, 2, 2, 2, 1184, 1158, 3, 2, 2, 2, 1184, 1160, 3, 2, 2, 2, 1184,
-	1163, 3, 2, 2, 2, 1184, 1166, 3, 2, 2, 2, 1184, 1171, 3, 2, 2, 2, 1184,
-	1176, 3, 2, 2, 2, 1184, 1180, 3, 2, 2, 2, 1185, 158, 3, 2, 2, 2, 1186,
-	1187, 7, 99, 2, 2, 1187, 1188, 7, 100, 2, 2, 1188, 1244, 7, 117, 2, 2,
-	1189, 1190, 7, 112, 2, 2, 1190, 1191, 7, 103, 2, 2, 1191, 1244, 7, 105,
-	2, 2, 1192, 1193, 7, 101, 2, 2, 1193, 1194, 7, 103, 2, 2, 1194, 1195, 7,
-	107, 2, 2, 1195, 1244, 7, 110, 2, 2, 1196, 1197, 7, 104, 2, 2, 1197, 1198,
-	7, 110, 2, 2, 1198, 1199, 7, 113, 2, 2, 1199, 1200, 7, 113, 2, 2, 1200,
-	1244, 7, 116, 2, 2, 1201, 1202, 7, 118, 2, 2, 1202, 1203, 7, 116, 2, 2,
-	1203, 1204, 7, 119, 2, 2, 1204, 1205, 7, 112, 2, 2, 1205, 1244, 7, 101,
-	2, 2, 1206, 1207, 7, 112, 2, 2, 1207, 1208, 7, 103, 2, 2, 1208, 1209, 7,
-	99, 2, 2, 1209, 1210, 7, 116, 2, 2, 1210, 1211, 7, 103, 2, 2, 1211, 1212,
-	7, 117, 2, 2, 1212, 1244, 7, 118, 2, 2, 1213, 1214, 7, 117, 2, 2, 1214,
-	1215, 7, 115, 2, 2, 1215, 1216, 7, 116, 2, 2, 1216, 1244, 7, 118, 2, 2,
-	1217, 1218, 7, 99, 2, 2, 1218, 1219, 7, 102, 2, 2, 1219, 1244, 7, 102,
-	2, 2, 1220, 1221, 7, 117, 2, 2, 1221, 1222, 7, 119, 2, 2, 1222, 1244, 7,
-	100, 2, 2, 1223, 1224, 7, 111, 2, 2, 1224, 1225, 7, 119, 2, 2, 1225, 1244,
-	7, 110, 2, 2, 1226, 1227, 7, 102, 2, 2, 1227, 1228, 7, 107, 2, 2, 1228,
-	1244, 7, 120, 2, 2, 1229, 1230, 7, 111, 2, 2, 1230, 1231, 7, 107, 2, 2,
-	1231, 1244, 7, 112, 2, 2, 1232, 1233, 7, 111, 2, 2, 1233, 1234, 7, 99,
-	2, 2, 1234, 1244, 7, 122, 2, 2, 1235, 1236, 7, 101, 2, 2, 1236, 1237, 7,
-	113, 2, 2, 1237, 1238, 7, 114, 2, 2, 1238, 1239, 7, 123, 2, 2, 1239, 1240,
-	7, 117, 2, 2, 1240, 1241, 7, 107, 2, 2, 1241, 1242, 7, 105, 2, 2, 1242,
-	1244, 7, 112, 2, 2, 1243, 1186, 3, 2, 2, 2, 1243, 1189, 3, 2, 2, 2, 1243,
-	1192, 3, 2, 2, 2, 1243, 1196, 3, 2, 2, 2, 1243, 1201, 3, 2, 2, 2, 1243,
-	1206, 3, 2, 2, 2, 1243, 1213, 3, 2, 2, 2, 1243, 1217, 3, 2, 2, 2, 1243,
-	1220, 3, 2, 2, 2, 1243, 1223, 3, 2, 2, 2, 1243, 1226, 3, 2, 2, 2, 1243,
-	1229, 3, 2, 2, 2, 1243, 1232, 3, 2, 2, 2, 1243, 1235, 3, 2, 2, 2, 1244,
-	160, 3, 2, 2, 2, 1245, 1246, 7, 103, 2, 2, 1246, 1247, 7, 115, 2, 2, 1247,
-	1285, 7, 124, 2, 2, 1248, 1249, 7, 103, 2, 2, 1249, 1285, 7, 115, 2, 2,
-	1250, 1251, 7, 112, 2, 2, 1251, 1285, 7, 103, 2, 2, 1252, 1253, 7, 110,
-	2, 2, 1253, 1254, 7, 118, 2, 2, 1254, 1255, 7, 97, 2, 2, 1255, 1285, 7,
-	117, 2, 2, 1256, 1257, 7, 110, 2, 2, 1257, 1258, 7, 118, 2, 2, 1258, 1259,
-	7, 97, 2, 2, 1259, 1285, 7, 119, 2, 2, 1260, 1261, 7, 105, 2, 2, 1261,
-	1262, 7, 118, 2, 2, 1262, 1263, 7, 97, 2, 2, 1263, 1285, 7, 117, 2, 2,
-	1264, 1265, 7, 105, 2, 2, 1265, 1266, 7, 118, 2, 2, 1266, 1267, 7, 97,
-	2, 2, 1267, 1285, 7, 119, 2, 2, 1268, 1269, 7, 110, 2, 2, 1269, 1270, 7,
-	103, 2, 2, 1270, 1271, 7, 97, 2, 2, 1271, 1285, 7, 117, 2, 2, 1272, 1273,
-	7, 110, 2, 2, 1273, 1274, 7, 103, 2, 2, 1274, 1275, 7, 97, 2, 2, 1275,
-	1285, 7, 119, 2, 2, 1276, 1277, 7, 105, 2, 2, 1277, 1278, 7, 103, 2, 2,
-	1278, 1279, 7, 97, 2, 2, 1279, 1285, 7, 117, 2, 2, 1280, 1281, 7, 105,
-	2, 2, 1281, 1282, 7, 103, 2, 2, 1282, 1283, 7, 97, 2, 2, 1283, 1285, 7,
-	119, 2, 2, 1284, 1245, 3, 2, 2, 2, 1284, 1248, 3, 2, 2, 2, 1284, 1250,
-	3, 2, 2, 2, 1284, 1252, 3, 2, 2, 2, 1284, 1256, 3, 2, 2, 2, 1284, 1260,
-	3, 2, 2, 2, 1284, 1264, 3, 2, 2, 2, 1284, 1268, 3, 2, 2, 2, 1284, 1272,
-	3, 2, 2, 2, 1284, 1276, 3, 2, 2, 2, 1284, 1280, 3, 2, 2, 2, 1285, 162,
-	3, 2, 2, 2, 1286, 1287, 7, 103, 2, 2, 1287, 1299, 7, 115, 2, 2, 1288, 1289,
-	7, 112, 2, 2, 1289, 1299, 7, 103, 2, 2, 1290, 1291, 7, 110, 2, 2, 1291,
-	1299, 7, 118, 2, 2, 1292, 1293, 7, 105, 2, 2, 1293, 1299, 7, 118, 2, 2,
-	1294, 1295, 7, 110, 2, 2, 1295, 1299, 7, 103, 2, 2, 1296, 1297, 7, 105,
-	2, 2, 1297, 1299, 7, 103, 2, 2, 1298, 1286, 3, 2, 2, 2, 1298, 1288, 3,
-	2, 2, 2, 1298, 1290, 3, 2, 2, 2, 1298, 1292, 3, 2, 2, 2, 1298, 1294, 3,
-	2, 2, 2, 1298, 1296, 3, 2, 2, 2, 1299, 164, 3, 2, 2, 2, 1300, 1302, 9,
-	9, 2, 2, 1301, 1300, 3, 2, 2, 2, 1302, 1303, 3, 2, 2, 2, 1303, 1301, 3,
-	2, 2, 2, 1303, 1304, 3, 2, 2, 2, 1304, 1305, 3, 2, 2, 2, 1305, 1306, 8,
-	83, 2, 2, 1306, 166, 3, 2, 2, 2, 1307, 1308, 7, 61, 2, 2, 1308, 1309, 7,
-	61, 2, 2, 1309, 1313, 3, 2, 2, 2, 1310, 1312, 10, 10, 2, 2, 1311, 1310,
-	3, 2, 2, 2, 1312, 1315, 3, 2, 2, 2, 1313, 1311, 3, 2, 2, 2, 1313, 1314,
-	3, 2, 2, 2, 1314, 1316, 3, 2, 2, 2, 1315, 1313, 3, 2, 2, 2, 1316, 1317,
-	8, 84, 2, 2, 1317, 168, 3, 2, 2, 2, 1318, 1319, 7, 42, 2, 2, 1319, 1320,
-	7, 61, 2, 2, 1320, 1325, 3, 2, 2, 2, 1321, 1324, 5, 169, 85, 2, 1322, 1324,
-	11, 2, 2, 2, 1323, 1321, 3, 2, 2, 2, 1323, 1322, 3, 2, 2, 2, 1324, 1327,
-	3, 2, 2, 2, 1325, 1326, 3, 2, 2, 2, 1325, 1323, 3, 2, 2, 2, 1326, 1328,
-	3, 2, 2, 2, 1327, 1325, 3, 2, 2, 2, 1328, 1329, 7, 61, 2, 2, 1329, 1330,
-	7, 43, 2, 2, 1330, 1331, 3, 2, 2, 2, 1331, 1332, 8, 85, 2, 2, 1332, 170,
-	3, 2, 2, 2, 45, 2, 594, 599, 601, 607, 612, 616, 619, 622, 627, 632, 640,
-	644, 647, 650, 658, 663, 669, 675, 685, 692, 695, 746, 828, 1029, 1039,
-	1044, 1049, 1054, 1082, 1086, 1092, 1100, 1108, 1112, 1184, 1243, 1284,
-	1298, 1303, 1313, 1323, 1325, 3, 8, 2, 2,
-}
-
-var lexerDeserializer = antlr.NewATNDeserializer(nil)
-var lexerAtn = lexerDeserializer.DeserializeFromUInt16(serializedLexerAtn)
-
-var lexerChannelNames = []string{
-	"DEFAULT_TOKEN_CHANNEL", "HIDDEN",
-}
-
-var lexerModeNames = []string{
-	"DEFAULT_MODE",
-}
-
-var lexerLiteralNames = []string{
-	"", "'('", "'register'", "')'", "'module'", "'binary'", "'quote'", "'invoke'",
-	"'get'", "'assert_return'", "'assert_trap'", "'assert_exhaustion'", "'assert_malformed'",
-	"'assert_invalid'", "'assert_unlinkable'", "'nan:canonical'", "'nan:arithmetic'",
-	"'script'", "'input'", "'output'", "'type'", "'func'", "'import'", "'table'",
-	"'memory'", "'global'", "'local'", "'elem'", "'data'", "'export'", "'start'",
-	"'offset'", "'mut'", "'funcref'", "'param'", "'result'", "'block'", "'loop'",
-	"'if'", "'then'", "'else'", "'end'", "'unreachable'", "'nop'", "'br'",
-	"'br_if'", "'br_table'", "'return'", "'call'", "'call_indirect'", "'drop'",
-	"'select'", "'memory.size'", "'memory.grow'", "'='", "'align'",
-}
-
-var lexerSymbolicNames = []string{
-	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
-	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
-	"", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "", "",
-	"", "", "VAL_TYPE", "NAME", "STRING", "FLOAT", "NAT", "INT", "VAR_OPS",
-	"MEM_OPS", "CST_OPS", "NUM_OPS", "WS", "LINE_COMMENT", "BLOCK_COMMENT",
-}
-
-var lexerRuleNames = []string{
-	"T__0", "T__1", "T__2", "T__3", "T__4", "T__5", "T__6", "T__7", "T__8",
-	"T__9", "T__10", "T__11", "T__12", "T__13", "T__14", "T__15", "T__16",
-	"T__17", "T__18", "T__19", "T__20", "T__21", "T__22", "T__23", "T__24",
-	"T__25", "T__26", "T__27", "T__28", "T__29", "T__30", "T__31", "T__32",
-	"T__33", "T__34", "T__35", "T__36", "T__37", "T__38", "T__39", "T__40",
-	"T__41", "T__42", "T__43", "T__44", "T__45", "T__46", "T__47", "T__48",
-	"T__49", "T__50", "T__51", "T__52", "T__53", "T__54", "VAL_TYPE", "NAME",
-	"STRING", "FLOAT", "NAT", "INT", "VAR_OPS", "MEM_OPS", "CST_OPS", "NUM_OPS",
-	"Sign", "Digit", "HexDigit", "Num", "HexNum", "NameChar", "StrChar", "StrEsc",
-	"OpSign", "IntType", "FloatType", "ValType", "IntArith", "FloatArith",
-	"IntRel", "FloatRel", "WS", "LINE_COMMENT", "BLOCK_COMMENT",
-}
-
-type WASTLexer struct {
-	*antlr.BaseLexer
-	channelNames []string
-	modeNames    []string
-	// TODO: EOF string
-}
-
-var lexerDecisionToDFA = make([]*antlr.DFA, len(lexerAtn.DecisionToState))
-
-func init() {
-	for index, ds := range lexerAtn.DecisionToState {
-		lexerDecisionToDFA[index] = antlr.NewDFA(ds, index)
-	}
-}
-
-func NewWASTLexer(input antlr.CharStream) *WASTLexer {
-
-	l := new(WASTLexer)
-
-	l.BaseLexer = antlr.NewBaseLexer(input)
-	l.Interpreter = antlr.NewLexerATNSimulator(l, lexerAtn, lexerDecisionToDFA, antlr.NewPredictionContextCache())
-
-	l.channelNames = lexerChannelNames
-	l.modeNames = lexerModeNames
-	l.RuleNames = lexerRuleNames
-	l.LiteralNames = lexerLiteralNames
-	l.SymbolicNames = lexerSymbolicNames
-	l.GrammarFileName = "WAST.g4"
-	// TODO: l.EOF = antlr.TokenEOF
-
-	return l
-}
-
-// WASTLexer tokens.
-const (
-	WASTLexerT__0          = 1
-	WASTLexerT__1          = 2
-	WASTLexerT__2          = 3
-	WASTLexerT__3          = 4
-	WASTLexerT__4          = 5
-	WASTLexerT__5          = 6
-	WASTLexerT__6          = 7
-	WASTLexerT__7          = 8
-	WASTLexerT__8          = 9
-	WASTLexerT__9          = 10
-	WASTLexerT__10         = 11
-	WASTLexerT__11         = 12
-	WASTLexerT__12         = 13
-	WASTLexerT__13         = 14
-	WASTLexerT__14         = 15
-	WASTLexerT__15         = 16
-	WASTLexerT__16         = 17
-	WASTLexerT__17         = 18
-	WASTLexerT__18         = 19
-	WASTLexerT__19         = 20
-	WASTLexerT__20         = 21
-	WASTLexerT__21         = 22
-	WASTLexerT__22         = 23
-	WASTLexerT__23         = 24
-	WASTLexerT__24         = 25
-	WASTLexerT__25         = 26
-	WASTLexerT__26         = 27
-	WASTLexerT__27         = 28
-	WASTLexerT__28         = 29
-	WASTLexerT__29         = 30
-	WASTLexerT__30         = 31
-	WASTLexerT__31         = 32
-	WASTLexerT__32         = 33
-	WASTLexerT__33         = 34
-	WASTLexerT__34         = 35
-	WASTLexerT__35         = 36
-	WASTLexerT__36         = 37
-	WASTLexerT__37         = 38
-	WASTLexerT__38         = 39
-	WASTLexerT__39         = 40
-	WASTLexerT__40         = 41
-	WASTLexerT__41         = 42
-	WASTLexerT__42         = 43
-	WASTLexerT__43         = 44
-	WASTLexerT__44         = 45
-	WASTLexerT__45         = 46
-	WASTLexerT__46         = 47
-	WASTLexerT__47         = 48
-	WASTLexerT__48         = 49
-	WASTLexerT__49         = 50
-	WASTLexerT__50         = 51
-	WASTLexerT__51         = 52
-	WASTLexerT__52         = 53
-	WASTLexerT__53         = 54
-	WASTLexerT__54         = 55
-	WASTLexerVAL_TYPE      = 56
-	WASTLexerNAME          = 57
-	WASTLexerSTRING        = 58
-	WASTLexerFLOAT         = 59
-	WASTLexerNAT           = 60
-	WASTLexerINT           = 61
-	WASTLexerVAR_OPS       = 62
-	WASTLexerMEM_OPS       = 63
-	WASTLexerCST_OPS       = 64
-	WASTLexerNUM_OPS       = 65
-	WASTLexerWS            = 66
-	WASTLexerLINE_COMMENT  = 67
-	WASTLexerBLOCK_COMMENT = 68
-)
@@ -0,0 +1,303 @@
+package text
+
+import (
+	"strings"
+
+	"github.com/zxh0/wasm.go/binary"
+)
+
+// expr : instr*
+// instr : plainInstr | blockInstr | foldedInstr
+func (p *watParser) parseInstrs() []binary.Instruction {
+	expr := make([]binary.Instruction, 0)
+	for {
+		tok := p.peek()
+		switch tok.kind {
+		case tokLPar:
+			if !isInstr(p.peekN(1)) {
+				return expr
+			}
+			expr = p.parseFoldedInstr(expr)
+		case tokKeyword:
+			if isBlockInstr(tok.text) {
+				expr = append(expr, p.parseBlockInstr())
+			} else if isInstr(tok) {
+				expr = append(expr, p.parsePlainInstr())
+			} else if tok.text == "end" || tok.text == "else" {
+				return expr
+			} else {
+				p.errUnexpected(tok, "instruction")
+			}
+		default:
+			return expr
+		}
+	}
+}
+
+func isInstr(tok token) bool {
+	if tok.kind != tokKeyword {
+		return false
+	}
+	_, ok := binary.GetOpcode(tok.text)
+	return ok
+}
+
+func isBlockInstr(op string) bool {
+	return op == "block" || op == "loop" || op == "if"
+}
+
+// parses foldedInstr:
+//
+//	foldedInstr : '(' plainInstr foldedInstr* ')'
+//	            | '(' 'block' NAME? blockType expr ')'
+//	            | '(' 'loop'  NAME? blockType expr ')'
+//	            | '(' 'if'    NAME? blockType foldedInstr*
+//	                  '(' 'then' expr ')' ('(' 'else' expr ')')? ')'
+//
+// The instructions are appended to expr in execution order.
+func (p *watParser) parseFoldedInstr(expr []binary.Instruction) []binary.Instruction {
+	p.expect(tokLPar)
+	op := p.peek().text
+	if !isInstr(p.peek()) {
+		p.errUnexpected(p.peek(), "instruction")
+	}
+	if !isBlockInstr(op) {
+		instr := p.parsePlainInstr()
+		for p.peek().kind == tokLPar {
+			expr = p.parseFoldedInstr(expr)
+		}
+		p.rpar()
+		return append(expr, instr)
+	}
+
+	p.next()
+	label := p.optName()
+	rt := p.parseBlockType()
+	if op == "if" {
+		for p.peek().kind == tokLPar && !p.isLPar("then") {
+			expr = p.parseFoldedInstr(expr)
+		}
+	}
+
+	p.codeBuilder.enterBlock()
+	defer p.codeBuilder.exitBlock()
+	if label.text != "" {
+		p.codeBuilder.defineLabel(label.text)
+		p.symbols.defineLabel(label, "("+op+" "+label.text+blockTypeStr(rt)+")",
+			p.codeBuilder.blockDepth)
+	}
+
+	var expr1, expr2 []binary.Instruction
+	if op == "if" {
+		p.expect(tokLPar)
+		p.expectKeyword("then")
+		expr1 = p.parseInstrs()
+		p.rpar()
+		expr2 = []binary.Instruction{}
+		if p.lpar("else") {
+			expr2 = p.parseInstrs()
+			p.rpar()
+		}
+	} else {
+		expr1 = p.parseInstrs()
+	}
+	p.rpar()
+	return append(expr, newBlockInstr(op, rt, expr1, expr2))
+}
+
+// parses blockInstr:
+//
+//	blockInstr : 'block' NAME? blockType expr 'end' NAME?
+//	           | 'loop'  NAME? blockType expr 'end' NAME?
+//	           | 'if'    NAME? blockType expr ('else' NAME? expr)? 'end' NAME?
+func (p *watParser) parseBlockInstr() binary.Instruction {
+	op := p.next().text
+	label := p.optName()
+	rt := p.parseBlockType()
+
+	p.codeBuilder.enterBlock()
+	defer p.codeBuilder.exitBlock()
+	if label.text != "" {
+		p.codeBuilder.defineLabel(label.text)
+		p.symbols.defineLabel(label, "("+op+" "+label.text+blockTypeStr(rt)+")",
+			p.codeBuilder.blockDepth)
+	}
+
+	expr1 := p.parseInstrs()
+	expr2 := []binary.Instruction{}
+	if op == "if" && p.peek().text == "else" {
+		p.next()
+		p.checkLabel(label)
+		expr2 = p.parseInstrs()
+	}
+	p.expectKeyword("end")
+	p.checkLabel(label)
+	return newBlockInstr(op, rt, expr1, expr2)
+}
+
+// the optional label after 'else' or 'end' must match the block label
+func (p *watParser) checkLabel(label token) {
+	if tok := p.optName(); tok.text != "" && tok.text != label.text {
+		p.reportErr(newSemanticError("mismatching label"), tok)
+	}
+}
+
+// parses plainInstr:
+//
+//	plainInstr : 'br' variable | 'br_if' variable | 'br_table' variable+
+//	           | 'br_on_null' variable | 'br_on_non_null' variable
+//	           | 'call' variable | 'call_indirect' typeUse
+//	           | 'call_ref' variable | 'return_call_ref' variable
+//	           | 'ref.null' heapType | 'ref.func' variable
+//	           | VAR_OPS variable | MEM_OPS memArg | CST_OPS value
+//	           | other opcodes without immediates
+func (p *watParser) parsePlainInstr() binary.Instruction {
+	op := p.next()
+	instr := newInstruction(op.text)
+
+	switch opcode := instr.Opcode; opcode {
+	case binary.Br, binary.BrIf, binary.BrOnNull, binary.BrOnNonNull:
+		_var := p.variable()
+		p.symbols.useLabel(_var, p.codeBuilder.blockDepth)
+		idx, err := p.codeBuilder.getBrLabelIdx(_var.text)
+		instr.Args = uint32(idx)
+		p.reportErr(err, _var)
+	case binary.BrTable:
+		labels := make([]uint32, 0, 1)
+		for _var := p.variable(); _var.text != ""; _var = p.optVar() {
+			p.symbols.useLabel(_var, p.codeBuilder.blockDepth)
+			idx, err := p.codeBuilder.getBrLabelIdx(_var.text)
+			labels = append(labels, uint32(idx))
+			p.reportErr(err, _var)
+		}
+		instr.Args = binary.BrTableArgs{
+			Labels:  labels[:len(labels)-1],
+			Default: labels[len(labels)-1],
+		}
+	case binary.Call, binary.RefFunc:
+		_var := p.variable()
+		p.symbols.use("func", _var)
+		idx, err := p.moduleBuilder.getFuncIdx(_var.text)
+		instr.Args = uint32(idx)
+		p.reportErr(err, _var)
+	case binary.CallIndirect:
+		ftIdx := p.parseTypeUseOf(nil, "type mismatch in call_indirect")
+		instr.Args = uint32(ftIdx)
+		// TODO
+	case binary.CallRef, binary.ReturnCallRef:
+		_var := p.variable()
+		p.symbols.use("type", _var)
+		idx, err := p.moduleBuilder.getFuncTypeIdx(_var.text)
+		instr.Args = uint32(idx)
+		p.reportErr(err, _var)
+	case binary.RefNull:
+		instr.Args = p.parseHeapType(true)
+	case binary.LocalGet, binary.LocalSet, binary.LocalTee:
+		_var := p.variable()
+		if p.codeBuilder != nil {
+			p.symbols.use("local", _var)
+			idx, err := p.codeBuilder.getLocalIdx(_var.text)
+			instr.Args = uint32(idx)
+			p.reportErr(err, _var)
+		} else {
+			instr.Args = p.u32(_var)
+		}
+	case binary.GlobalGet, binary.GlobalSet:
+		_var := p.variable()
+		p.symbols.use("global", _var)
+		idx, err := p.moduleBuilder.getGlobalIdx(_var.text)
+		instr.Args = uint32(idx)
+		p.reportErr(err, _var)
+	case binary.I32Const, binary.I64Const, binary.F32Const, binary.F64Const:
+		instr.Args = p.parseConst(opcode)
+	default:
+		if opcode >= binary.I32Load && opcode <= binary.I64Store32 {
+			instr.Args = p.parseMemArg(opcode)
+		}
+	}
+	return instr
+}
+
+// constInstr : CST_OPS value
+func (p *watParser) parseConst(opcode byte) interface{} {
+	val := p.expect(tokNum)
+	defer p.attachPos(val)
+	switch opcode {
+	case binary.I32Const:
+		return parseI32(val.text)
+	case binary.I64Const:
+		return parseI64(val.text)
+	case binary.F32Const:
+		return parseF32(val.text)
+	default:
+		return parseF64(val.text)
+	}
+}
+
+// memArg : ('offset=' nat)? ('align=' nat)?
+func (p *watParser) parseMemArg(opcode byte) binary.MemArg {
+	memArg := binary.MemArg{Align: binary.NaturalAlign(opcode)}
+	if offset, ok := p.optKeyValue("offset="); ok {
+		memArg.Offset = p.u64(offset)
+	}
+	if align, ok := p.optKeyValue("align="); ok {
+		switch p.u32(align) {
+		case 1:
+			memArg.Align = 0
+		case 2:
+			memArg.Align = 1
+		case 4:
+			memArg.Align = 2
+		case 8:
+			memArg.Align = 3
+		case 16:
+			memArg.Align = 4
+		case 32:
+			memArg.Align = 5
+		case 64:
+			memArg.Align = 6
+		default:
+			p.reportErr(newSemanticError("invalid align"), align) // TODO
+		}
+	}
+	return memArg
+}
+
+// returns the nat of an optional key=nat keyword
+func (p *watParser) optKeyValue(key string) (token, bool) {
+	tok := p.peek()
+	if tok.kind != tokKeyword || !strings.HasPrefix(tok.text, key) {
+		return token{}, false
+	}
+	p.next()
+	val := token{
+		kind:   tokNum,
+		text:   tok.text[len(key):],
+		line:   tok.line,
+		column: tok.column + len(key),
+	}
+	if !isNat(val.text) {
+		p.errUnexpected(val, "natural number")
+	}
+	return val, true
+}
+
+func (p *watParser) u32(tok token) uint32 {
+	defer p.attachPos(tok)
+	return parseU32(tok.text)
+}
+
+func (p *watParser) u64(tok token) uint64 {
+	defer p.attachPos(tok)
+	return parseU64(tok.text)
+}
+
+// attaches the position of tok to a SemanticError without one
+func (p *watParser) attachPos(tok token) {
+	if r := recover(); r != nil {
+		if err, ok := r.(*SemanticError); ok && err.token.line == 0 {
+			err.token = tok
+		}
+		panic(r)
+	}
+}
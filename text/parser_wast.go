@@ -0,0 +1,311 @@
+package text
+
+import (
+	"strings"
+)
+
+type wastParser struct {
+	watParser
+	scriptBuilder *scriptBuilder
+}
+
+func newWastParser(toks []token) *wastParser {
+	p := &wastParser{}
+	p.toks = toks
+	p.moduleBuilder = newModuleBuilder()
+	return p
+}
+
+// script : cmd* EOF
+func (p *wastParser) parseScript() *Script {
+	s := p.parseCmds()
+	p.expect(tokEOF)
+	return s
+}
+
+// An error only stops the command, the remaining commands are still compiled.
+func (p *wastParser) parseCmds() *Script {
+	outer := p.scriptBuilder
+	defer func() { p.scriptBuilder = outer }()
+	p.scriptBuilder = newScriptBuilder()
+
+	for p.peek().kind == tokLPar {
+		start := p.pos
+		end := p.skipSExpr()
+		p.pos = start
+		p.try(func() {
+			p.scriptBuilder.addCmd(p.parseCmd())
+		})
+		p.pos = end
+	}
+	return p.scriptBuilder.script
+}
+
+// parses cmd:
+//
+//	cmd : wastModule
+//	    | '(' 'register' STRING NAME? ')'
+//	    | action
+//	    | assertion
+//	    | meta
+func (p *wastParser) parseCmd() interface{} {
+	kw := p.peekN(1)
+	switch kw.text {
+	case "module":
+		return p.parseWastModule()
+	case "register":
+		p.pos += 2
+		r := &Register{Line: kw.line, ModuleName: p.str()}
+		name := p.optName()
+		p.symbols.use("module", name)
+		r.Name = name.text
+		p.rpar()
+		return r
+	case "invoke", "get":
+		return p.parseAction()
+	case "assert_return", "assert_trap", "assert_exhaustion",
+		"assert_malformed", "assert_invalid", "assert_unlinkable":
+		return p.parseAssertion()
+	case "script", "input", "output":
+		return p.parseMeta()
+	default:
+		p.errUnexpected(kw, "command")
+		return nil
+	}
+}
+
+// parses wastModule:
+//
+//	wastModule : module
+//	           | '(' 'module' NAME? 'binary' STRING* ')'
+//	           | '(' 'module' NAME? 'quote'  STRING* ')'
+func (p *wastParser) parseWastModule() interface{} {
+	line := p.expect(tokLPar).line
+	p.expectKeyword("module")
+	nameTok := p.optName()
+	p.symbols.define("module", nameTok, "(module "+nameTok.text+")")
+	name := nameTok.text
+	switch p.peek().text {
+	case "binary":
+		p.next()
+		m := &BinaryModule{Line: line, Name: name, Data: p.strs()}
+		p.rpar()
+		return m
+	case "quote":
+		p.next()
+		m := &QuotedModule{Line: line, Name: name, Text: string(p.strs())}
+		p.rpar()
+		return m
+	default:
+		return p.parseModuleFields(line, name)
+	}
+}
+
+// parses action:
+//
+//	action : '(' 'invoke' NAME? STRING const* ')'
+//	       | '(' 'get'    NAME? STRING ')'
+func (p *wastParser) parseAction() *Action {
+	a := &Action{Line: p.expect(tokLPar).line}
+	switch kw := p.next(); kw.text {
+	case "invoke":
+		a.Kind = ActionInvoke
+	case "get":
+		a.Kind = ActionGet
+	default:
+		p.errUnexpected(kw, "action")
+	}
+
+	moduleName := p.optName()
+	p.symbols.use("module", moduleName)
+	a.ModuleName = moduleName.text
+	a.ItemName = p.str()
+	if a.Kind == ActionInvoke {
+		for p.peek().kind == tokLPar {
+			a.Args = append(a.Args, p.parseConst())
+		}
+	}
+	p.rpar()
+	return a
+}
+
+// parses assertion:
+//
+//	assertion : '(' 'assert_return'     action expected* ')'
+//	          | '(' 'assert_trap'       action     STRING ')'
+//	          | '(' 'assert_exhaustion' action     STRING ')'
+//	          | '(' 'assert_malformed'  wastModule STRING ')'
+//	          | '(' 'assert_invalid'    wastModule STRING ')'
+//	          | '(' 'assert_unlinkable' wastModule STRING ')'
+//	          | '(' 'assert_trap'       wastModule STRING ')'
+func (p *wastParser) parseAssertion() *Assertion {
+	a := &Assertion{Line: p.expect(tokLPar).line}
+	switch p.next().text {
+	case "assert_return":
+		a.Kind = AssertReturn
+	case "assert_trap":
+		a.Kind = AssertTrap
+	case "assert_exhaustion":
+		a.Kind = AssertExhaustion
+	case "assert_malformed":
+		a.Kind = AssertMalformed
+	case "assert_invalid":
+		a.Kind = AssertInvalid
+	case "assert_unlinkable":
+		a.Kind = AssertUnlinkable
+	}
+
+	if p.isLPar("module") {
+		a.Module = p.parseWastModule()
+	} else {
+		a.Action = p.parseAction()
+	}
+	if a.Kind == AssertReturn {
+		for p.peek().kind == tokLPar {
+			a.Results = append(a.Results, p.parseExpected())
+		}
+	} else {
+		a.Failure = p.str()
+	}
+	p.rpar()
+	return a
+}
+
+// parses expected:
+//
+//	expected : '(' CST_OPS value ')'
+//	         | '(' CST_OPS 'nan:canonical' ')'
+//	         | '(' CST_OPS 'nan:arithmetic' ')'
+//	         | '(' 'ref.null' ('func' | 'extern') ')'
+//	         | '(' 'ref.func' variable? ')'
+//	         | '(' 'ref.extern' nat ')'
+//	         | '(' 'v128.const' SHAPE (value | nan)* ')'
+func (p *wastParser) parseExpected() Result {
+	p.expect(tokLPar)
+	op := p.next()
+	var r Result
+	switch op.text {
+	case "i32.const", "i64.const", "f32.const", "f64.const":
+		r = p.parseExpectedConst(strings.TrimSuffix(op.text, ".const"))
+	case "ref.null":
+		ht := p.next()
+		if ht.text != "func" && ht.text != "extern" {
+			p.errUnexpected(ht, "heap type")
+		}
+		r = Result{Kind: ResultRefNull, Type: ht.text}
+	case "ref.func":
+		p.optVar()
+		r = Result{Kind: ResultRefFunc}
+	case "ref.extern":
+		r = Result{Kind: ResultRefExtern, Value: p.u32(p.nat())}
+	case "v128.const":
+		r = p.parseExpectedV128()
+	default:
+		p.errUnexpected(op, "result")
+	}
+	p.rpar()
+	return r
+}
+
+// parses const, which is expected without NaN patterns and ref.func
+func (p *wastParser) parseConst() Result {
+	op := p.peekN(1)
+	r := p.parseExpected()
+	if !isConst(r) {
+		p.errUnexpected(op, "constant")
+	}
+	return r
+}
+
+func isConst(r Result) bool {
+	switch r.Kind {
+	case ResultConst, ResultRefNull, ResultRefExtern:
+		return true
+	case ResultV128:
+		for _, lane := range r.Lanes {
+			if lane.Kind != ResultConst {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func (p *wastParser) parseExpectedConst(t string) Result {
+	val := p.peek()
+	if val.text == "nan:canonical" {
+		p.next()
+		return Result{Kind: ResultCanonicalNaN, Type: t}
+	}
+	if val.text == "nan:arithmetic" {
+		p.next()
+		return Result{Kind: ResultArithmeticNaN, Type: t}
+	}
+
+	p.expect(tokNum)
+	defer p.attachPos(val)
+	r := Result{Kind: ResultConst, Type: t}
+	switch t {
+	case "i8":
+		r.Value = int32(int8(parseInt(val.text, 8)))
+	case "i16":
+		r.Value = int32(int16(parseInt(val.text, 16)))
+	case "i32":
+		r.Value = parseI32(val.text)
+	case "i64":
+		r.Value = parseI64(val.text)
+	case "f32":
+		r.Value = parseF32(val.text)
+	case "f64":
+		r.Value = parseF64(val.text)
+	}
+	return r
+}
+
+var v128Shapes = map[string]int{
+	"i8x16": 16, "i16x8": 8, "i32x4": 4, "i64x2": 2, "f32x4": 4, "f64x2": 2,
+}
+
+func (p *wastParser) parseExpectedV128() Result {
+	shape := p.next()
+	n, ok := v128Shapes[shape.text]
+	if !ok {
+		p.errUnexpected(shape, "v128 shape")
+	}
+	laneType := shape.text[:strings.IndexByte(shape.text, 'x')]
+	r := Result{Kind: ResultV128, Type: shape.text}
+	for p.peek().kind != tokRPar && p.peek().kind != tokEOF {
+		r.Lanes = append(r.Lanes, p.parseExpectedConst(laneType))
+	}
+	if len(r.Lanes) != n {
+		p.reportErr(newSemanticError("wrong number of lane literals"), shape)
+	}
+	return r
+}
+
+// parses meta:
+//
+//	meta : '(' 'script' NAME? cmd* ')'
+//	     | '(' 'input'  NAME? STRING ')'
+//	     | '(' 'output' NAME? STRING? ')'
+func (p *wastParser) parseMeta() *Meta {
+	m := &Meta{Line: p.expect(tokLPar).line}
+	kw := p.next()
+	m.Name = p.optName().text
+	switch kw.text {
+	case "script":
+		m.Kind = MetaScript
+		m.Script = p.parseCmds()
+	case "input":
+		m.Kind = MetaInput
+		m.FileName = p.str()
+	case "output":
+		m.Kind = MetaOutput
+		if p.peek().kind == tokString {
+			m.FileName = p.str()
+		}
+	}
+	p.rpar()
+	return m
+}
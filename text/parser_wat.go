@@ -0,0 +1,833 @@
+package text
+
+import (
+	"math"
+
+	"github.com/zxh0/wasm.go/binary"
+)
+
+type watParser struct {
+	parser
+	errorReporter
+	moduleBuilder *moduleBuilder
+	codeBuilder   *codeBuilder
+	emitNames     bool
+	symbols       *symbolRecorder // nil if not analyzing
+}
+
+func newWatParser(toks []token, opts CompileOptions) *watParser {
+	return &watParser{
+		parser: parser{toks: toks},
+		errorReporter: errorReporter{
+			reportsValidationError: true,
+		},
+		emitNames: opts.Names,
+	}
+}
+
+// module : '(' 'module' NAME? moduleField* ')'
+func (p *watParser) parseModule() *Module {
+	line := p.expect(tokLPar).line
+	p.expectKeyword("module")
+	name := p.optName()
+	p.symbols.define("module", name, "(module "+name.text+")")
+	return p.parseModuleFields(line, name.text)
+}
+
+// Fields are parsed in 3 passes: type definitions, names, other fields.
+// An error only stops the field, the remaining fields are still compiled.
+func (p *watParser) parseModuleFields(line int, name string) *Module {
+	nameAnn, hasNameAnn := p.optNameAnn()
+	var fields []int
+	for p.peek().kind == tokLPar {
+		fields = append(fields, p.pos)
+		p.skipSExpr()
+	}
+	p.rpar()
+	end := p.pos
+
+	p.moduleBuilder = newModuleBuilder()
+	if hasNameAnn {
+		p.moduleBuilder.setModuleNameAnn(nameAnn)
+	}
+	p.symbols.enterModule()
+	failed := map[int]bool{}
+	for _, field := range fields {
+		if !p.tryField(field, p.parseTypeDef) || !p.tryField(field, p.defineNames) {
+			failed[field] = true
+		}
+	}
+	for _, field := range fields {
+		if !failed[field] { // avoid follow-up errors
+			p.tryField(field, p.parseModuleField)
+		}
+	}
+	p.pos = end
+
+	if p.emitNames || p.moduleBuilder.hasNameAnns() {
+		names := p.moduleBuilder.getNameSec(name, p.emitNames)
+		p.moduleBuilder.addCustomSec(binary.CustomSec{Name: "name", Data: names.Encode()})
+	}
+	return &Module{
+		Line:   line,
+		Name:   name,
+		Module: p.moduleBuilder.getModule(),
+	}
+}
+
+func (p *watParser) tryField(pos int, parseField func()) bool {
+	p.pos = pos
+	p.codeBuilder = nil
+	return p.try(parseField)
+}
+
+// typeDef : '(' 'type' NAME? '(' 'func' funcType ')' ')'
+func (p *watParser) parseTypeDef() {
+	if !p.lpar("type") {
+		return
+	}
+	name := p.optName()
+	p.expect(tokLPar)
+	p.expectKeyword("func")
+	ft := p.parseFuncType(nil)
+	p.rpar()
+	p.rpar()
+	err := p.moduleBuilder.addTypeDef(name.text, ft)
+	p.reportErr(err, name)
+	p.symbols.define("type", name, "(type "+name.text+" (func"+prefixSpace(funcTypeStr(ft, nil))+"))")
+}
+
+// builds symbol tables
+func (p *watParser) defineNames() {
+	p.expect(tokLPar)
+	kw := p.next()
+	switch kw.text {
+	case "import":
+		err := p.moduleBuilder.ensureNoNonImports()
+		p.reportErr(err, kw)
+		p.expect(tokString)
+		p.expect(tokString)
+		p.expect(tokLPar)
+		kind := p.next()
+		if !isImportKind(kind.text) {
+			p.errUnexpected(kind, "import kind")
+		}
+		name := p.optName()
+		err = p.moduleBuilder.checkCount(kind.text)
+		p.reportErr(err, kw)
+		err = p.moduleBuilder.importName(kind.text, name.text)
+		p.reportErr(err, name)
+		p.symbols.define(kind.text, name, "")
+	case "func", "table", "memory", "global":
+		name := p.optName()
+		p.optNameAnn()
+		p.parseEmbeddedEx()
+		err := p.moduleBuilder.checkCount(kw.text)
+		p.reportErr(err, kw)
+		if p.isLPar("import") {
+			err = p.moduleBuilder.ensureNoNonImports()
+			p.reportErr(err, p.peekN(1))
+			err = p.moduleBuilder.importName(kw.text, name.text)
+		} else {
+			err = p.moduleBuilder.defineName(kw.text, name.text)
+		}
+		p.reportErr(err, name)
+		p.symbols.define(kw.text, name, "")
+	case "type", "export", "start", "elem", "data", "@custom":
+	default:
+		p.errUnexpected(kw, "module field")
+	}
+}
+
+func isImportKind(s string) bool {
+	return s == "func" || s == "table" || s == "memory" || s == "global"
+}
+
+func (p *watParser) parseModuleField() {
+	switch p.peekN(1).text {
+	case "import":
+		p.parseImport()
+	case "func":
+		p.parseFunc()
+	case "table":
+		p.parseTable()
+	case "memory":
+		p.parseMemory()
+	case "global":
+		p.parseGlobal()
+	case "export":
+		p.parseExport()
+	case "start":
+		p.parseStart()
+	case "elem":
+		p.parseElem()
+	case "data":
+		p.parseData()
+	case "@custom":
+		p.parseCustom()
+	}
+}
+
+// parses import:
+//
+//	import     : '(' 'import' STRING STRING importDesc ')'
+//	importDesc : '(' 'func'   NAME? typeUse ')'
+//	           | '(' 'table'  NAME? tableType ')'
+//	           | '(' 'memory' NAME? memoryType ')'
+//	           | '(' 'global' NAME? globalType ')'
+func (p *watParser) parseImport() {
+	p.pos += 2
+	imp := binary.Import{Module: p.str(), Name: p.str()}
+	p.expect(tokLPar)
+	kind := p.next()
+	name := p.optName()
+	nameAnn, hasNameAnn := p.optNameAnn()
+	switch kind.text {
+	case "func":
+		imp.Desc = binary.ImportDesc{
+			Tag:      binary.ImportTagFunc,
+			FuncType: uint32(p.parseTypeUse(nil)),
+		}
+	case "table":
+		imp.Desc = binary.ImportDesc{
+			Tag:   binary.ImportTagTable,
+			Table: p.parseTableType(),
+		}
+	case "memory":
+		imp.Desc = binary.ImportDesc{
+			Tag: binary.ImportTagMem,
+			Mem: p.parseMemoryType(),
+		}
+	case "global":
+		imp.Desc = binary.ImportDesc{
+			Tag:    binary.ImportTagGlobal,
+			Global: p.parseGlobalType(),
+		}
+	}
+	p.rpar()
+	p.rpar()
+	idx, err := p.moduleBuilder.addImport(imp)
+	p.reportErr(err, kind)
+	if hasNameAnn && kind.text == "func" {
+		p.moduleBuilder.setFuncNameAnn(idx, nameAnn)
+	}
+	p.symbols.describe(kind.text, name, p.getImportDetail(name, imp.Desc))
+}
+
+// parses func:
+//
+//	func      : '(' 'func' NAME? embeddedEx typeUse funcLocal* expr ')'
+//	          | '(' 'func' NAME? embeddedEx embeddedIm typeUse ')'
+//	funcLocal : '(' 'local' valType* ')'
+//	          | '(' 'local' NAME valType ')'
+func (p *watParser) parseFunc() {
+	kw := p.peekN(1)
+	p.pos += 2
+	name := p.optName()
+	nameAnn, hasNameAnn := p.optNameAnn()
+	exports := p.parseEmbeddedEx()
+	p.codeBuilder = newCodeBuilder()
+	p.symbols.enterFunc()
+
+	idx := 0
+	if imp, ok := p.parseEmbeddedIm(); ok {
+		imp.Desc = binary.ImportDesc{
+			Tag:      binary.ImportTagFunc,
+			FuncType: uint32(p.parseTypeUse(p.codeBuilder)),
+		}
+		var err error
+		idx, err = p.moduleBuilder.addImport(imp)
+		p.reportErr(err, kw)
+		p.symbols.describe("func", name, p.getImportDetail(name, imp.Desc))
+	} else {
+		ftIdx := p.parseTypeUse(p.codeBuilder)
+		p.symbols.describe("func", name, p.getImportDetail(name, binary.ImportDesc{
+			Tag:      binary.ImportTagFunc,
+			FuncType: uint32(ftIdx),
+		}))
+		for p.lpar("local") {
+			local := p.optName()
+			if ann, ok := p.optNameAnn(); ok || local.text != "" {
+				vt := p.parseValType()
+				err := p.codeBuilder.addLocal(local.text, vt)
+				p.reportErr(err, local)
+				if ok {
+					p.codeBuilder.setNameAnn(ann)
+				}
+				p.symbols.define("local", local, "(local "+local.text+" "+vt.String()+")")
+			} else {
+				for p.peek().kind != tokRPar {
+					_ = p.codeBuilder.addLocal("", p.parseValType())
+				}
+			}
+			p.rpar()
+		}
+		expr := p.parseInstrs()
+		idx = p.moduleBuilder.addFunc(ftIdx, p.codeBuilder.locals, expr)
+	}
+	p.rpar()
+
+	for _, name := range exports {
+		p.moduleBuilder.addExport(name, binary.ExportTagFunc, idx)
+	}
+	p.moduleBuilder.setLocalNames(idx, p.codeBuilder.localNames)
+	p.moduleBuilder.setLocalNameAnns(idx, p.codeBuilder.nameAnns)
+	if hasNameAnn {
+		p.moduleBuilder.setFuncNameAnn(idx, nameAnn)
+	}
+	p.codeBuilder = nil
+}
+
+// parses table:
+//
+//	table : '(' 'table' NAME? embeddedEx tableType ')'
+//	      | '(' 'table' NAME? embeddedEx embeddedIm tableType ')'
+//	      | '(' 'table' NAME? embeddedEx elemType '(' 'elem' funcVars ')' ')'
+func (p *watParser) parseTable() {
+	kw := p.peekN(1)
+	p.pos += 2
+	name := p.optName()
+	exports := p.parseEmbeddedEx()
+	if imp, ok := p.parseEmbeddedIm(); ok {
+		imp.Desc = binary.ImportDesc{
+			Tag:   binary.ImportTagTable,
+			Table: p.parseTableType(),
+		}
+		_, err := p.moduleBuilder.addImport(imp)
+		p.reportErr(err, kw)
+		p.symbols.describe("table", name, p.getImportDetail(name, imp.Desc))
+	} else if p.peek().kind == tokNum {
+		tt := p.parseTableType()
+		err := p.moduleBuilder.addTable(tt)
+		p.reportErr(err, kw)
+		p.symbols.describe("table", name, p.getImportDetail(name, binary.ImportDesc{
+			Tag:   binary.ImportTagTable,
+			Table: tt,
+		}))
+	} else {
+		p.expectKeyword("funcref")
+		p.expect(tokLPar)
+		p.expectKeyword("elem")
+		funcIndices := p.parseFuncVars()
+		p.rpar()
+		err := p.moduleBuilder.addTableWithElems(funcIndices)
+		p.reportErr(err, kw)
+	}
+	p.rpar()
+
+	for _, name := range exports {
+		p.moduleBuilder.addExport(name, binary.ExportTagTable, 0)
+	}
+}
+
+// parses memory:
+//
+//	memory : '(' 'memory' NAME? embeddedEx memoryType ')'
+//	       | '(' 'memory' NAME? embeddedEx embeddedIm memoryType ')'
+//	       | '(' 'memory' NAME? embeddedEx addrType? '(' 'data' STRING* ')' ')'
+func (p *watParser) parseMemory() {
+	kw := p.peekN(1)
+	p.pos += 2
+	name := p.optName()
+	exports := p.parseEmbeddedEx()
+	if imp, ok := p.parseEmbeddedIm(); ok {
+		imp.Desc = binary.ImportDesc{
+			Tag: binary.ImportTagMem,
+			Mem: p.parseMemoryType(),
+		}
+		_, err := p.moduleBuilder.addImport(imp)
+		p.reportErr(err, kw)
+		p.symbols.describe("memory", name, p.getImportDetail(name, imp.Desc))
+	} else if is64 := p.optAddrType(); p.lpar("data") {
+		initData := p.strs()
+		p.rpar()
+		offset := []binary.Instruction{newI32Const0()}
+		min := uint64(math.Ceil(float64(len(initData)) / binary.PageSize))
+		mt := binary.Limits{Min: min} // TODO
+		if is64 {
+			offset[0] = binary.Instruction{Opcode: binary.I64Const, Args: int64(0)}
+			mt.Tag = binary.LimitsFlag64
+		}
+		err := p.moduleBuilder.addMemory(mt)
+		p.reportErr(err, kw)
+		_ = p.moduleBuilder.addData("", offset, initData)
+	} else {
+		mt := p.parseLimitsOf(is64)
+		err := p.moduleBuilder.addMemory(mt)
+		p.reportErr(err, kw)
+		p.symbols.describe("memory", name, p.getImportDetail(name, binary.ImportDesc{
+			Tag: binary.ImportTagMem,
+			Mem: mt,
+		}))
+	}
+	p.rpar()
+
+	for _, name := range exports {
+		p.moduleBuilder.addExport(name, binary.ExportTagMem, 0) // TODO
+	}
+}
+
+// parses global:
+//
+//	global : '(' 'global' NAME? embeddedEx globalType expr ')'
+//	       | '(' 'global' NAME? embeddedEx embeddedIm globalType ')'
+func (p *watParser) parseGlobal() {
+	kw := p.peekN(1)
+	p.pos += 2
+	name := p.optName()
+	exports := p.parseEmbeddedEx()
+	idx := 0
+	if imp, ok := p.parseEmbeddedIm(); ok {
+		imp.Desc = binary.ImportDesc{
+			Tag:    binary.ImportTagGlobal,
+			Global: p.parseGlobalType(),
+		}
+		var err error
+		idx, err = p.moduleBuilder.addImport(imp)
+		p.reportErr(err, kw)
+		p.symbols.describe("global", name, p.getImportDetail(name, imp.Desc))
+	} else {
+		gt := p.parseGlobalType()
+		p.symbols.describe("global", name, p.getImportDetail(name, binary.ImportDesc{
+			Tag:    binary.ImportTagGlobal,
+			Global: gt,
+		}))
+		expr := p.parseInstrs()
+		idx = p.moduleBuilder.addGlobal(gt, expr)
+	}
+	p.rpar()
+
+	for _, name := range exports {
+		p.moduleBuilder.addExport(name, binary.ExportTagGlobal, idx)
+	}
+}
+
+// export     : '(' 'export' STRING exportDesc ')'
+// exportDesc : '(' ('func' | 'table' | 'memory' | 'global') variable ')'
+func (p *watParser) parseExport() {
+	p.pos += 2
+	name := p.str()
+	p.expect(tokLPar)
+	kind := p.next()
+	_var := p.variable()
+	p.rpar()
+	p.rpar()
+	p.symbols.use(kind.text, _var)
+
+	var idx int
+	var err error
+	switch kind.text {
+	case "func":
+		idx, err = p.moduleBuilder.getFuncIdx(_var.text)
+		p.moduleBuilder.addExport(name, binary.ExportTagFunc, idx)
+	case "table":
+		idx, err = p.moduleBuilder.getTableIdx(_var.text)
+		p.moduleBuilder.addExport(name, binary.ExportTagTable, idx)
+	case "memory":
+		idx, err = p.moduleBuilder.getMemIdx(_var.text)
+		p.moduleBuilder.addExport(name, binary.ExportTagMem, idx)
+	case "global":
+		idx, err = p.moduleBuilder.getGlobalIdx(_var.text)
+		p.moduleBuilder.addExport(name, binary.ExportTagGlobal, idx)
+	default:
+		p.errUnexpected(kind, "export kind")
+	}
+	p.reportErr(err, _var)
+}
+
+// start : '(' 'start' variable ')'
+func (p *watParser) parseStart() {
+	kw := p.peekN(1)
+	p.pos += 2
+	err := p.moduleBuilder.ensureNoStart()
+	p.reportErr(err, kw)
+	_var := p.variable()
+	p.rpar()
+	p.symbols.use("func", _var)
+	err = p.moduleBuilder.addStart(_var.text)
+	p.reportErr(err, _var)
+}
+
+// parses elem:
+//
+//	elem : '(' 'elem' variable? '(' 'offset' expr ')' funcVars ')'
+//	     | '(' 'elem' variable?              expr     funcVars ')'
+//	     | '(' 'elem' 'declare' 'func' funcVars ')'
+func (p *watParser) parseElem() {
+	p.pos += 2
+	if tok := p.peek(); tok.kind == tokKeyword && tok.text == "declare" {
+		p.next()
+		p.expectKeyword("func")
+		initData := p.parseFuncVars()
+		p.rpar()
+		p.moduleBuilder.declareElem(initData)
+		return
+	}
+	_var := p.optVar()
+	p.symbols.use("table", _var)
+	offset := p.parseOffset()
+	initData := p.parseFuncVars()
+	p.rpar()
+	err := p.moduleBuilder.addElem(_var.text, offset, initData)
+	p.reportErr(err, _var)
+}
+
+// parses data:
+//
+//	data : '(' 'data' variable? '(' 'offset' expr ')' STRING* ')'
+//	     | '(' 'data' variable?              expr     STRING* ')'
+func (p *watParser) parseData() {
+	p.pos += 2
+	_var := p.optVar()
+	p.symbols.use("memory", _var)
+	offset := p.parseOffset()
+	initData := p.strs()
+	p.rpar()
+	err := p.moduleBuilder.addData(_var.text, offset, initData)
+	p.reportErr(err, _var)
+}
+
+func (p *watParser) parseOffset() []binary.Instruction {
+	if p.lpar("offset") {
+		expr := p.parseInstrs()
+		p.rpar()
+		return expr
+	}
+	return p.parseInstrs()
+}
+
+// embeddedEx : ('(' 'export' STRING ')')*
+func (p *watParser) parseEmbeddedEx() []string {
+	var names []string
+	for p.lpar("export") {
+		names = append(names, p.str())
+		p.rpar()
+	}
+	return names
+}
+
+// embeddedIm : '(' 'import' STRING STRING ')'
+func (p *watParser) parseEmbeddedIm() (binary.Import, bool) {
+	if !p.lpar("import") {
+		return binary.Import{}, false
+	}
+	imp := binary.Import{Module: p.str(), Name: p.str()}
+	p.rpar()
+	return imp, true
+}
+
+// typeUse : ('(' 'type' variable ')')? funcType
+// The params are defined in cb if it is not nil.
+func (p *watParser) parseTypeUse(cb *codeBuilder) int {
+	return p.parseTypeUseOf(cb, "type mismatch")
+}
+func (p *watParser) parseTypeUseOf(cb *codeBuilder, mismatchMsg string) int {
+	var kw, _var token
+	if p.isLPar("type") {
+		kw = p.peekN(1)
+		p.pos += 2
+		_var = p.variable()
+		p.rpar()
+		p.symbols.use("type", _var)
+	}
+	ft := p.parseFuncType(cb)
+	if _var.text == "" {
+		return p.moduleBuilder.addTypeUse(ft)
+	}
+
+	idx, err := p.moduleBuilder.getFuncTypeIdx(_var.text)
+	if err != nil {
+		p.reportErr(err, _var)
+		return idx
+	}
+	ftUse := p.moduleBuilder.getFuncType(idx)
+	if len(ft.ParamTypes) == 0 && len(ft.ResultTypes) == 0 {
+		if cb != nil {
+			for range ftUse.ParamTypes {
+				_ = cb.addParam("")
+			}
+		}
+	} else if ft.GetSignature() != ftUse.GetSignature() {
+		p.reportErr(newVerificationError(mismatchMsg), kw)
+	}
+	return idx
+}
+
+// funcVars : variable*
+func (p *watParser) parseFuncVars() []binary.FuncIdx {
+	funcIndices := make([]binary.FuncIdx, 0)
+	for _var := p.optVar(); _var.text != ""; _var = p.optVar() {
+		p.symbols.use("func", _var)
+		idx, err := p.moduleBuilder.getFuncIdx(_var.text)
+		p.reportErr(err, _var)
+		funcIndices = append(funcIndices, uint32(idx))
+	}
+	return funcIndices
+}
+
+/* types */
+
+// parses valType:
+//
+//	valType  : 'i32' | 'i64' | 'f32' | 'f64' | 'funcref' | 'externref'
+//	         | '(' 'ref' 'null'? heapType ')'
+//	heapType : 'func' | 'extern' | variable
+func (p *watParser) parseValType() binary.ValType {
+	if p.lpar("ref") {
+		nullable := false
+		if tok := p.peek(); tok.kind == tokKeyword && tok.text == "null" {
+			nullable = true
+			p.next()
+		}
+		vt := p.parseHeapType(nullable)
+		p.rpar()
+		return vt
+	}
+
+	tok := p.next()
+	if tok.kind == tokKeyword {
+		switch tok.text {
+		case "i32":
+			return binary.ValTypeI32
+		case "i64":
+			return binary.ValTypeI64
+		case "f32":
+			return binary.ValTypeF32
+		case "f64":
+			return binary.ValTypeF64
+		case "funcref":
+			return binary.ValTypeFuncRef
+		case "externref":
+			return binary.ValTypeExternRef
+		}
+	}
+	p.errUnexpected(tok, "value type")
+	return 0
+}
+
+func (p *watParser) parseHeapType(nullable bool) binary.ValType {
+	if tok := p.peek(); tok.kind == tokKeyword && tok.text == "func" {
+		p.next()
+		if nullable {
+			return binary.ValTypeFuncRef
+		}
+		return binary.RefNonNull
+	}
+	if tok := p.peek(); tok.kind == tokKeyword && tok.text == "extern" {
+		p.next()
+		if !nullable {
+			p.reportErr(newSemanticError("unsupported reference type: (ref extern)"), tok)
+		}
+		return binary.ValTypeExternRef
+	}
+	_var := p.variable()
+	p.symbols.use("type", _var)
+	idx, err := p.moduleBuilder.getFuncTypeIdx(_var.text)
+	p.reportErr(err, _var)
+	return binary.RefType(nullable, uint32(idx))
+}
+
+// blockType : result*
+func (p *watParser) parseBlockType() binary.BlockType {
+	rt := binary.BlockType{}
+	for p.isLPar("result") {
+		rt = append(rt, p.parseResult()...)
+	}
+	return rt
+}
+
+// globalType : valType | '(' 'mut' valType ')'
+func (p *watParser) parseGlobalType() binary.GlobalType {
+	if p.lpar("mut") {
+		vt := p.parseValType()
+		p.rpar()
+		return binary.GlobalType{ValType: vt, Mut: binary.MutVar}
+	}
+	return binary.GlobalType{ValType: p.parseValType(), Mut: binary.MutConst}
+}
+
+// tableType : limits elemType
+// elemType  : 'funcref'
+func (p *watParser) parseTableType() binary.TableType {
+	limits := p.parseLimits()
+	p.expectKeyword("funcref")
+	return binary.TableType{
+		ElemType: binary.FuncRef,
+		Limits:   limits,
+	}
+}
+
+// memoryType : addrType? limits
+func (p *watParser) parseMemoryType() binary.Limits {
+	return p.parseLimitsOf(p.optAddrType())
+}
+
+// addrType : 'i32' | 'i64'
+func (p *watParser) optAddrType() (is64 bool) {
+	if tok := p.peek(); tok.kind == tokKeyword &&
+		(tok.text == "i32" || tok.text == "i64") {
+
+		p.next()
+		return tok.text == "i64"
+	}
+	return false
+}
+
+// limits : nat nat?
+func (p *watParser) parseLimits() binary.Limits {
+	return p.parseLimitsOf(false)
+}
+
+// the limits of 64-bit memories are u64
+func (p *watParser) parseLimitsOf(is64 bool) binary.Limits {
+	limits := binary.Limits{}
+	parseN := func(tok token) uint64 { return uint64(p.u32(tok)) }
+	if is64 {
+		limits.Tag = binary.LimitsFlag64
+		parseN = p.u64
+	}
+	limits.Min = parseN(p.nat())
+	if tok := p.peek(); tok.kind == tokNum && isNat(tok.text) {
+		limits.Tag |= binary.LimitsFlagMax
+		limits.Max = parseN(p.next())
+	}
+	return limits
+}
+
+// parses funcType:
+//
+//	funcType : param* result*
+//	param    : '(' 'param' valType* ')'
+//	         | '(' 'param' NAME valType ')'
+func (p *watParser) parseFuncType(cb *codeBuilder) binary.FuncType {
+	ft := binary.FuncType{}
+	for p.lpar("param") {
+		name := p.optName()
+		if ann, ok := p.optNameAnn(); ok || name.text != "" {
+			vt := p.parseValType()
+			ft.ParamTypes = append(ft.ParamTypes, vt)
+			if cb != nil {
+				err := cb.addParam(name.text)
+				p.reportErr(err, name)
+				if ok {
+					cb.setNameAnn(ann)
+				}
+				p.symbols.define("local", name, "(param "+name.text+" "+vt.String()+")")
+			}
+		} else {
+			for p.peek().kind != tokRPar {
+				ft.ParamTypes = append(ft.ParamTypes, p.parseValType())
+				if cb != nil {
+					_ = cb.addParam("")
+				}
+			}
+		}
+		p.rpar()
+	}
+	for p.isLPar("result") {
+		ft.ResultTypes = append(ft.ResultTypes, p.parseResult()...)
+	}
+	return ft
+}
+
+// result : '(' 'result' valType* ')'
+func (p *watParser) parseResult() []binary.ValType {
+	p.pos += 2
+	results := make([]binary.ValType, 0, 1)
+	for p.peek().kind != tokRPar {
+		results = append(results, p.parseValType())
+	}
+	p.rpar()
+	return results
+}
+
+// the definition of an imported or defined field for hovers
+func (p *watParser) getImportDetail(name token, desc binary.ImportDesc) string {
+	var s string
+	switch desc.Tag {
+	case binary.ImportTagFunc:
+		ft := p.moduleBuilder.getFuncType(int(desc.FuncType))
+		s = "func " + name.text + prefixSpace(funcTypeStr(ft, nil))
+	case binary.ImportTagTable:
+		s = "table " + name.text + " " + tableTypeStr(desc.Table)
+	case binary.ImportTagMem:
+		s = "memory " + name.text + " " + limitsStr(desc.Mem)
+	case binary.ImportTagGlobal:
+		s = "global " + name.text + " " + globalTypeStr(desc.Global)
+	}
+	return "(" + s + ")"
+}
+
+/* annotations */
+
+// nameAnn : '(' '@name' STRING ')'
+func (p *watParser) optNameAnn() (string, bool) {
+	if !p.isAnnot("@name") {
+		return "", false
+	}
+	p.pos += 2
+	name := p.str()
+	p.rpar()
+	return name, true
+}
+
+// parses custom:
+//
+//	custom    : '(' '@custom' STRING placement? STRING* ')'
+//	placement : '(' 'before' ('first' | secName) ')'
+//	          | '(' 'after'  ('last'  | secName) ')'
+func (p *watParser) parseCustom() {
+	p.pos += 2
+	sec := binary.CustomSec{Name: p.str(), After: binary.CustomSecLast}
+	if p.peek().kind == tokLPar {
+		p.next()
+		kw := p.next()
+		place := p.next()
+		secID := getSecID(place.text)
+		switch {
+		case kw.text == "before" && place.text == "first":
+			sec.After = binary.CustomSecFirst
+		case kw.text == "after" && place.text == "last":
+			sec.After = binary.CustomSecLast
+		case kw.text != "before" && kw.text != "after":
+			p.errUnexpected(kw, `"before" or "after"`)
+		case secID < 0:
+			p.errUnexpected(place, "section name")
+		case kw.text == "after":
+			sec.After = byte(secID)
+		case secID == binary.SecTypeID:
+			sec.After = binary.CustomSecFirst
+		default:
+			sec.After = byte(secID - 1)
+		}
+		p.rpar()
+	}
+	sec.Data = p.strs()
+	p.rpar()
+	p.moduleBuilder.addCustomSec(sec)
+}
+
+// names of the non-custom sections in custom placements
+var secNames = []string{
+	binary.SecTypeID:   "type",
+	binary.SecImportID: "import",
+	binary.SecFuncID:   "func",
+	binary.SecTableID:  "table",
+	binary.SecMemID:    "memory",
+	binary.SecGlobalID: "global",
+	binary.SecExportID: "export",
+	binary.SecStartID:  "start",
+	binary.SecElemID:   "elem",
+	binary.SecCodeID:   "code",
+	binary.SecDataID:   "data",
+}
+
+// -1 if s is not a section name
+func getSecID(s string) int {
+	for id, name := range secNames {
+		if name == s && name != "" {
+			return id
+		}
+	}
+	return -1
+}
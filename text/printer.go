@@ -0,0 +1,696 @@
+package text
+
+import (
+	"fmt"
+	"io"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/zxh0/wasm.go/binary"
+)
+
+type PrintOptions struct {
+	Folded      bool // print instructions as S-expressions
+	Annotations bool // print custom sections and original names as annotations
+}
+
+// Print writes m in the text format (like wasm2wat).
+func Print(m binary.Module, w io.Writer) error {
+	return PrintWithOptions(m, w, PrintOptions{})
+}
+
+func PrintWithOptions(m binary.Module, w io.Writer, opts PrintOptions) error {
+	p := newPrinter(m, opts)
+	p.printModule()
+	_, err := io.WriteString(w, strings.Join(p.lines, "\n")+"\n")
+	return err
+}
+
+type printer struct {
+	opts       PrintOptions
+	module     binary.Module
+	funcTypes  []binary.FuncType // func_idx -> type
+	funcNames  []string          // func_idx -> $name or ""
+	localNames [][]string        // func_idx -> local_idx -> $name or ""
+	funcDecls  []string          // like funcNames, with (@name) annotations
+	localDecls [][]string        // like localNames, with (@name) annotations
+	moduleName string
+	hasNames   bool // the name section is valid
+	lines      []string
+	indent     int
+}
+
+func newPrinter(m binary.Module, opts PrintOptions) *printer {
+	p := &printer{opts: opts, module: m}
+	for _, imp := range m.ImportSec {
+		if imp.Desc.Tag == binary.ImportTagFunc {
+			p.funcTypes = append(p.funcTypes, p.getType(imp.Desc.FuncType))
+		}
+	}
+	for _, ftIdx := range m.FuncSec {
+		p.funcTypes = append(p.funcTypes, p.getType(ftIdx))
+	}
+	p.initNames()
+	return p
+}
+
+func (p *printer) getType(ftIdx binary.TypeIdx) binary.FuncType {
+	if int(ftIdx) < len(p.module.TypeSec) {
+		return p.module.TypeSec[ftIdx]
+	}
+	return binary.FuncType{}
+}
+
+// invalid name sections are ignored
+func (p *printer) initNames() {
+	p.funcNames = make([]string, len(p.funcTypes))
+	p.localNames = make([][]string, len(p.funcTypes))
+	p.funcDecls = p.funcNames
+	p.localDecls = p.localNames
+	names, err := p.module.GetNameSec()
+	if names == nil || err != nil {
+		return
+	}
+	p.hasNames = true
+	if names.ModuleName != "" {
+		p.moduleName = p.getDecl("$"+sanitizeName(names.ModuleName), names.ModuleName)
+	}
+
+	used := map[string]bool{}
+	for i := range p.funcNames {
+		p.funcNames[i] = uniqueName(names.FuncNames[uint32(i)], i, used)
+	}
+	for fIdx, m := range names.LocalNames {
+		if int(fIdx) >= len(p.funcTypes) {
+			continue
+		}
+		n := len(p.funcTypes[fIdx].ParamTypes)
+		if code := p.getCode(int(fIdx)); code != nil {
+			n += code.GetLocalCount()
+		}
+		used := map[string]bool{}
+		p.localNames[fIdx] = make([]string, n)
+		for i := range p.localNames[fIdx] {
+			p.localNames[fIdx][i] = uniqueName(m[uint32(i)], i, used)
+		}
+	}
+	if !p.opts.Annotations {
+		return
+	}
+
+	p.funcDecls = make([]string, len(p.funcNames))
+	for i, name := range p.funcNames {
+		p.funcDecls[i] = p.getDecl(name, names.FuncNames[uint32(i)])
+	}
+	p.localDecls = make([][]string, len(p.localNames))
+	for fIdx, localNames := range p.localNames {
+		p.localDecls[fIdx] = make([]string, len(localNames))
+		for i, name := range localNames {
+			p.localDecls[fIdx][i] = p.getDecl(name, names.LocalNames[uint32(fIdx)][uint32(i)])
+		}
+	}
+}
+
+// $id (@name "name") if the name is not kept by the $id
+func (p *printer) getDecl(id, name string) string {
+	if p.opts.Annotations && id != "" && id != "$"+name {
+		return id + ` (@name ` + quote([]byte(name)) + `)`
+	}
+	return id
+}
+
+func uniqueName(name string, idx int, used map[string]bool) string {
+	if name == "" {
+		return ""
+	}
+	name = "$" + sanitizeName(name)
+	if used[name] {
+		name += "." + strconv.Itoa(idx)
+	}
+	used[name] = true
+	return name
+}
+
+// replaces the characters that are not allowed in identifiers
+func sanitizeName(name string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' ||
+			strings.ContainsRune("_.+-*/\\^~=<>!?@#$%&|:'`", r) {
+			return r
+		}
+		return '_'
+	}, name)
+}
+
+func (p *printer) getCode(fIdx int) *binary.Code {
+	i := fIdx - (len(p.funcTypes) - len(p.module.CodeSec))
+	if i >= 0 && i < len(p.module.CodeSec) {
+		return &p.module.CodeSec[i]
+	}
+	return nil
+}
+
+/* output */
+
+func (p *printer) line(format string, a ...interface{}) {
+	p.lines = append(p.lines,
+		strings.Repeat("  ", p.indent)+fmt.Sprintf(format, a...))
+}
+func (p *printer) open(format string, a ...interface{}) {
+	p.line("("+format, a...)
+	p.indent++
+}
+func (p *printer) close() {
+	p.lines[len(p.lines)-1] += ")"
+	p.indent--
+}
+
+/* module fields */
+
+func (p *printer) printModule() {
+	p.open("module" + prefixSpace(p.moduleName))
+	for i, ft := range p.module.TypeSec {
+		p.line("(type (;%d;) (func%s))", i, prefixSpace(funcTypeStr(ft, nil)))
+	}
+	p.printImports()
+	for i := range p.module.CodeSec {
+		p.printFunc(len(p.funcTypes) - len(p.module.CodeSec) + i)
+	}
+	tableIdx := p.importedCount(binary.ImportTagTable)
+	for i, tt := range p.module.TableSec {
+		p.line("(table (;%d;) %s)", tableIdx+i, tableTypeStr(tt))
+	}
+	memIdx := p.importedCount(binary.ImportTagMem)
+	for i, mt := range p.module.MemSec {
+		p.line("(memory (;%d;) %s)", memIdx+i, limitsStr(mt))
+	}
+	globalIdx := p.importedCount(binary.ImportTagGlobal)
+	for i, g := range p.module.GlobalSec {
+		p.line("(global (;%d;) %s %s)", globalIdx+i,
+			globalTypeStr(g.Type), p.constExprStr(g.Expr))
+	}
+	for _, exp := range p.module.ExportSec {
+		p.line("(export %s (%s %s))", quote([]byte(exp.Name)),
+			exportKinds[exp.Desc.Tag], p.exportVar(exp.Desc))
+	}
+	if p.module.StartSec != nil {
+		p.line("(start %s)", p.funcVar(*p.module.StartSec))
+	}
+	for i, elem := range p.module.ElemSec {
+		s := fmt.Sprintf("(elem (;%d;)", i)
+		if elem.IsDeclarative() {
+			s += " declare func"
+		} else {
+			if elem.Table != 0 {
+				s += fmt.Sprintf(" %d", elem.Table)
+			}
+			s += " " + p.constExprStr(elem.Offset)
+		}
+		for _, fIdx := range elem.Init {
+			s += " " + p.funcVar(fIdx)
+		}
+		p.line("%s)", s)
+	}
+	for i, data := range p.module.DataSec {
+		s := fmt.Sprintf("(data (;%d;)", i)
+		if data.Mem != 0 {
+			s += fmt.Sprintf(" %d", data.Mem)
+		}
+		p.line("%s %s %s)", s, p.constExprStr(data.Offset), quote(data.Init))
+	}
+	if p.opts.Annotations {
+		p.printCustomSecs()
+	}
+	p.close()
+}
+
+// the valid name section is printed as $identifiers
+func (p *printer) printCustomSecs() {
+	for _, sec := range p.module.CustomSecs {
+		if sec.Name != "name" || !p.hasNames {
+			p.line("(@custom %s%s %s)", quote([]byte(sec.Name)),
+				placementStr(sec.After), quote(sec.Data))
+		}
+	}
+}
+
+func placementStr(after byte) string {
+	switch {
+	case after == binary.CustomSecFirst:
+		return " (before first)"
+	case int(after) < len(secNames) && secNames[after] != "":
+		return " (after " + secNames[after] + ")"
+	}
+	return ""
+}
+
+var exportKinds = []string{"func", "table", "memory", "global"}
+
+func (p *printer) exportVar(desc binary.ExportDesc) string {
+	if desc.Tag == binary.ExportTagFunc {
+		return p.funcVar(desc.Idx)
+	}
+	return strconv.Itoa(int(desc.Idx))
+}
+
+func (p *printer) importedCount(tag byte) int {
+	n := 0
+	for _, imp := range p.module.ImportSec {
+		if imp.Desc.Tag == tag {
+			n++
+		}
+	}
+	return n
+}
+
+func (p *printer) printImports() {
+	var counts [4]int
+	for _, imp := range p.module.ImportSec {
+		tag := imp.Desc.Tag
+		idx := counts[tag]
+		counts[tag]++
+
+		var desc string
+		switch tag {
+		case binary.ImportTagFunc:
+			ft := p.getType(imp.Desc.FuncType)
+			desc = fmt.Sprintf("func%s (;%d;) (type %d)%s",
+				prefixSpace(p.funcDecls[idx]), idx, imp.Desc.FuncType,
+				prefixSpace(funcTypeStr(ft, nil)))
+		case binary.ImportTagTable:
+			desc = fmt.Sprintf("table (;%d;) %s", idx, tableTypeStr(imp.Desc.Table))
+		case binary.ImportTagMem:
+			desc = fmt.Sprintf("memory (;%d;) %s", idx, limitsStr(imp.Desc.Mem))
+		case binary.ImportTagGlobal:
+			desc = fmt.Sprintf("global (;%d;) %s", idx, globalTypeStr(imp.Desc.Global))
+		}
+		p.line("(import %s %s (%s))",
+			quote([]byte(imp.Module)), quote([]byte(imp.Name)), desc)
+	}
+}
+
+func (p *printer) printFunc(fIdx int) {
+	code := p.getCode(fIdx)
+	ftIdx := p.module.FuncSec[fIdx-(len(p.funcTypes)-len(p.module.CodeSec))]
+	names, decls := p.localNames[fIdx], p.localDecls[fIdx]
+	p.open("func%s (;%d;) (type %d)%s", prefixSpace(p.funcDecls[fIdx]),
+		fIdx, ftIdx, prefixSpace(funcTypeStr(p.funcTypes[fIdx], decls)))
+
+	localIdx := len(p.funcTypes[fIdx].ParamTypes)
+	var locals []binary.ValType
+	for _, l := range code.Locals {
+		for i := uint32(0); i < l.N; i++ {
+			locals = append(locals, l.Type)
+		}
+	}
+	if len(locals) > 0 {
+		p.line("%s", valTypesStr("local", locals, decls, localIdx))
+	}
+
+	p.printExpr(code.Expr, names)
+	p.close()
+}
+
+func (p *printer) printExpr(expr binary.Expr, names []string) {
+	if p.opts.Folded {
+		for _, n := range p.fold(expr) {
+			p.printNode(n, names)
+		}
+	} else {
+		p.printFlat(expr, names)
+	}
+}
+
+func (p *printer) printFlat(expr binary.Expr, names []string) {
+	for _, instr := range expr {
+		switch instr.Opcode {
+		case binary.Block, binary.Loop:
+			p.line("%s", p.instrStr(instr, names))
+			p.indent++
+			p.printFlat(instr.Args.(binary.BlockArgs).Instrs, names)
+			p.indent--
+			p.line("end")
+		case binary.If:
+			args := instr.Args.(binary.IfArgs)
+			p.line("%s", p.instrStr(instr, names))
+			p.indent++
+			p.printFlat(args.Instrs1, names)
+			if len(args.Instrs2) > 0 {
+				p.indent--
+				p.line("else")
+				p.indent++
+				p.printFlat(args.Instrs2, names)
+			}
+			p.indent--
+			p.line("end")
+		default:
+			p.line("%s", p.instrStr(instr, names))
+		}
+	}
+}
+
+/* folded instructions */
+
+type node struct {
+	instr    binary.Instruction
+	children []*node
+}
+
+// Folds the operands of instructions with known arity into them,
+// the evaluation order is never changed.
+func (p *printer) fold(expr binary.Expr) []*node {
+	var nodes []*node
+	avail := 0 // trailing nodes that produce exactly one value
+	for _, instr := range expr {
+		n := &node{instr: instr}
+		params, results, ok := p.arity(instr)
+		if ok && params <= avail {
+			n.children = append(n.children, nodes[len(nodes)-params:]...)
+			nodes = nodes[:len(nodes)-params]
+			avail -= params
+		} else {
+			avail = 0
+		}
+		nodes = append(nodes, n)
+		if ok && results == 1 {
+			avail++
+		} else {
+			avail = 0
+		}
+	}
+	return nodes
+}
+
+func (p *printer) printNode(n *node, names []string) {
+	switch n.instr.Opcode {
+	case binary.Block, binary.Loop:
+		p.open("%s", p.instrStr(n.instr, names))
+		p.printExpr(n.instr.Args.(binary.BlockArgs).Instrs, names)
+		p.close()
+	case binary.If:
+		args := n.instr.Args.(binary.IfArgs)
+		p.open("%s", p.instrStr(n.instr, names))
+		for _, child := range n.children {
+			p.printNode(child, names)
+		}
+		p.open("then")
+		p.printExpr(args.Instrs1, names)
+		p.close()
+		if len(args.Instrs2) > 0 {
+			p.open("else")
+			p.printExpr(args.Instrs2, names)
+			p.close()
+		}
+		p.close()
+	default:
+		if len(n.children) == 0 {
+			p.line("(%s)", p.instrStr(n.instr, names))
+			return
+		}
+		p.open("%s", p.instrStr(n.instr, names))
+		for _, child := range n.children {
+			p.printNode(child, names)
+		}
+		p.close()
+	}
+}
+
+// constant expressions are printed folded in one line
+func (p *printer) constExprStr(expr binary.Expr) string {
+	var ss []string
+	for _, n := range p.fold(expr) {
+		ss = append(ss, p.inlineNodeStr(n))
+	}
+	return strings.Join(ss, " ")
+}
+
+func (p *printer) inlineNodeStr(n *node) string {
+	s := "(" + p.instrStr(n.instr, nil)
+	for _, child := range n.children {
+		s += " " + p.inlineNodeStr(child)
+	}
+	return s + ")"
+}
+
+// returns the number of operands and results,
+// ok is false if they depend on the context (branches etc.)
+func (p *printer) arity(instr binary.Instruction) (params, results int, ok bool) {
+	opcode := instr.Opcode
+	switch opcode {
+	case binary.Block, binary.Loop:
+		return 0, len(instr.Args.(binary.BlockArgs).RT), true
+	case binary.If:
+		return 1, len(instr.Args.(binary.IfArgs).RT), true
+	case binary.Call:
+		if fIdx := int(instr.Args.(uint32)); fIdx < len(p.funcTypes) {
+			ft := p.funcTypes[fIdx]
+			return len(ft.ParamTypes), len(ft.ResultTypes), true
+		}
+		return 0, 0, false
+	case binary.CallIndirect:
+		ft := p.getType(instr.Args.(uint32))
+		return len(ft.ParamTypes) + 1, len(ft.ResultTypes), true
+	}
+	return getInstrArity(opcode)
+}
+
+// the arity of instructions that do not depend on the module
+func getInstrArity(opcode byte) (params, results int, ok bool) {
+	switch opcode {
+	case binary.Drop, binary.LocalSet, binary.GlobalSet:
+		return 1, 0, true
+	case binary.Select:
+		return 3, 1, true
+	case binary.LocalGet, binary.GlobalGet, binary.MemorySize,
+		binary.I32Const, binary.I64Const, binary.F32Const, binary.F64Const,
+		binary.RefNull, binary.RefFunc:
+		return 0, 1, true
+	case binary.LocalTee, binary.MemoryGrow, binary.RefIsNull, binary.RefAsNonNull:
+		return 1, 1, true
+	case binary.I32Eqz, binary.I64Eqz:
+		return 1, 1, true
+	}
+	switch {
+	case opcode >= binary.I32Load && opcode <= binary.I64Load32U:
+		return 1, 1, true
+	case opcode >= binary.I32Store && opcode <= binary.I64Store32:
+		return 2, 0, true
+	case opcode >= binary.I32Clz && opcode <= binary.I32PopCnt,
+		opcode >= binary.I64Clz && opcode <= binary.I64PopCnt,
+		opcode >= binary.F32Abs && opcode <= binary.F32Sqrt,
+		opcode >= binary.F64Abs && opcode <= binary.F64Sqrt,
+		opcode >= binary.I32WrapI64 && opcode <= binary.F64ReinterpretI64:
+		return 1, 1, true
+	case opcode >= binary.I32Eq && opcode <= binary.F64CopySign:
+		return 2, 1, true
+	}
+	return 0, 0, false
+}
+
+/* instructions */
+
+func (p *printer) instrStr(instr binary.Instruction, names []string) string {
+	name := instr.GetOpname()
+	switch instr.Opcode {
+	case binary.Block, binary.Loop:
+		return name + blockTypeStr(instr.Args.(binary.BlockArgs).RT)
+	case binary.If:
+		return name + blockTypeStr(instr.Args.(binary.IfArgs).RT)
+	case binary.Br, binary.BrIf, binary.BrOnNull, binary.BrOnNonNull,
+		binary.CallRef, binary.ReturnCallRef, binary.GlobalGet, binary.GlobalSet:
+		return fmt.Sprintf("%s %d", name, instr.Args)
+	case binary.BrTable:
+		args := instr.Args.(binary.BrTableArgs)
+		s := name
+		for _, l := range args.Labels {
+			s += fmt.Sprintf(" %d", l)
+		}
+		return fmt.Sprintf("%s %d", s, args.Default)
+	case binary.Call, binary.RefFunc:
+		return name + " " + p.funcVar(instr.Args.(uint32))
+	case binary.CallIndirect:
+		return fmt.Sprintf("%s (type %d)", name, instr.Args)
+	case binary.LocalGet, binary.LocalSet, binary.LocalTee:
+		idx := instr.Args.(uint32)
+		if int(idx) < len(names) && names[idx] != "" {
+			return name + " " + names[idx]
+		}
+		return fmt.Sprintf("%s %d", name, idx)
+	case binary.I32Const, binary.I64Const:
+		return fmt.Sprintf("%s %d", name, instr.Args)
+	case binary.F32Const:
+		return name + " " + f32Str(instr.Args.(float32))
+	case binary.F64Const:
+		return name + " " + f64Str(instr.Args.(float64))
+	case binary.RefNull:
+		vt := instr.Args.(binary.ValType)
+		if idx, ok := vt.TypeIdx(); ok {
+			return fmt.Sprintf("%s %d", name, idx)
+		}
+		if vt == binary.ValTypeExternRef {
+			return name + " extern"
+		}
+		return name + " func"
+	}
+	if instr.Opcode >= binary.I32Load && instr.Opcode <= binary.I64Store32 {
+		return name + memArgStr(instr.Opcode, instr.Args.(binary.MemArg))
+	}
+	return name
+}
+
+func (p *printer) funcVar(fIdx uint32) string {
+	if int(fIdx) < len(p.funcNames) && p.funcNames[fIdx] != "" {
+		return p.funcNames[fIdx]
+	}
+	return strconv.Itoa(int(fIdx))
+}
+
+func memArgStr(opcode byte, memArg binary.MemArg) string {
+	s := ""
+	if memArg.Offset != 0 {
+		s += fmt.Sprintf(" offset=%d", memArg.Offset)
+	}
+	if memArg.Align != binary.NaturalAlign(opcode) {
+		s += fmt.Sprintf(" align=%d", uint64(1)<<memArg.Align)
+	}
+	return s
+}
+
+/* types */
+
+func blockTypeStr(rt binary.BlockType) string {
+	if len(rt) == 0 {
+		return ""
+	}
+	return " " + valTypesStr("result", rt, nil, 0)
+}
+
+// names are the names of params
+func funcTypeStr(ft binary.FuncType, names []string) string {
+	var ss []string
+	if len(ft.ParamTypes) > 0 {
+		ss = append(ss, valTypesStr("param", ft.ParamTypes, names, 0))
+	}
+	if len(ft.ResultTypes) > 0 {
+		ss = append(ss, valTypesStr("result", ft.ResultTypes, nil, 0))
+	}
+	return strings.Join(ss, " ")
+}
+
+// (param i32 i32) or (param $a i32) (param $b i32) if named,
+// names[idx] is the name of vts[0]
+func valTypesStr(kind string, vts []binary.ValType, names []string, idx int) string {
+	named := false
+	for i := range vts {
+		if idx+i < len(names) && names[idx+i] != "" {
+			named = true
+		}
+	}
+	if !named {
+		s := "(" + kind
+		for _, vt := range vts {
+			s += " " + vt.String()
+		}
+		return s + ")"
+	}
+
+	var ss []string
+	for i, vt := range vts {
+		if name := names[idx+i]; name != "" {
+			ss = append(ss, fmt.Sprintf("(%s %s %s)", kind, name, vt))
+		} else {
+			ss = append(ss, fmt.Sprintf("(%s %s)", kind, vt))
+		}
+	}
+	return strings.Join(ss, " ")
+}
+
+func tableTypeStr(tt binary.TableType) string {
+	return limitsStr(tt.Limits) + " funcref"
+}
+
+func limitsStr(limits binary.Limits) string {
+	s := ""
+	if limits.Tag&binary.LimitsFlag64 != 0 {
+		s = "i64 "
+	}
+	s += strconv.FormatUint(limits.Min, 10)
+	if limits.Tag&binary.LimitsFlagMax != 0 {
+		s += " " + strconv.FormatUint(limits.Max, 10)
+	}
+	return s
+}
+
+func globalTypeStr(gt binary.GlobalType) string {
+	if gt.Mut == binary.MutVar {
+		return "(mut " + gt.ValType.String() + ")"
+	}
+	return gt.ValType.String()
+}
+
+/* values */
+
+// hex floats are exact, e.g. 0x1.8p+1
+func f32Str(f float32) string {
+	if f != f {
+		bits := math.Float32bits(f)
+		return nanStr(bits>>31 != 0, uint64(bits&0x7fffff), 0x400000)
+	}
+	return hexFloatStr(strconv.FormatFloat(float64(f), 'x', -1, 32))
+}
+
+func f64Str(f float64) string {
+	if f != f {
+		bits := math.Float64bits(f)
+		return nanStr(bits>>63 != 0, bits&0xfffffffffffff, 0x8000000000000)
+	}
+	return hexFloatStr(strconv.FormatFloat(f, 'x', -1, 64))
+}
+
+func nanStr(neg bool, payload, canonical uint64) string {
+	s := "nan"
+	if payload != canonical {
+		s += fmt.Sprintf(":0x%x", payload)
+	}
+	if neg {
+		s = "-" + s
+	}
+	return s
+}
+
+// removes the leading zero of exponents (0x1p+01 -> 0x1p+1)
+func hexFloatStr(s string) string {
+	switch s {
+	case "+Inf":
+		return "inf"
+	case "-Inf":
+		return "-inf"
+	}
+	if i := strings.IndexByte(s, 'p'); i > 0 && s[i+2] == '0' && len(s) > i+3 {
+		s = s[:i+2] + s[i+3:]
+	}
+	return s
+}
+
+// printable ASCII is kept, others are escaped as \hh
+func quote(data []byte) string {
+	sb := strings.Builder{}
+	sb.WriteByte('"')
+	for _, b := range data {
+		if b >= 0x20 && b < 0x7f && b != '"' && b != '\\' {
+			sb.WriteByte(b)
+		} else {
+			fmt.Fprintf(&sb, "\\%02x", b)
+		}
+	}
+	sb.WriteByte('"')
+	return sb.String()
+}
+
+func prefixSpace(s string) string {
+	if s == "" {
+		return ""
+	}
+	return " " + s
+}
@@ -0,0 +1,231 @@
+package text
+
+import (
+	"math"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zxh0/wasm.go/binary"
+)
+
+const printerTestWAT = `(module
+  (type $v2v (func))
+  (import "env" "print" (func $print (param i32)))
+  (import "env" "mem" (memory 1))
+  (import "env" "g" (global $g i32))
+  (table 2 funcref)
+  (global $h (mut i64) (i64.const -1))
+  (func $fac (export "fac") (param i32) (result i32)
+    (local i32 i64)
+    (if (result i32) (i32.lt_s (local.get 0) (i32.const 2))
+      (then (i32.const 1))
+      (else
+        (i32.mul (local.get 0)
+          (call $fac (i32.sub (local.get 0) (i32.const 1)))))))
+  (func $misc (type $v2v)
+    block $b
+      loop
+        i32.const 1
+        br_if 1
+        i32.const 0
+        br_table 0 1 0
+      end
+    end
+    (i32.store8 offset=3 align=1 (i32.const 8) (i32.load16_u (i32.const 4)))
+    (i64.store align=4 (i32.const 0) (global.get $h))
+    (call $print (i32.const 7))
+    (drop (select (f32.const -0x1.8p+1) (f32.const nan:0x200000) (global.get $g)))
+    (drop (f64.const 0.1))
+    (call_indirect (type $v2v) (i32.const 1)))
+  (elem (i32.const 0) $misc $fac)
+  (data (i32.const 16) "hi\00\ff\"\\")
+  (start $misc))`
+
+func TestPrintRoundTrip(t *testing.T) {
+	m, err := CompileModuleStr(printerTestWAT)
+	require.NoError(t, err)
+	testPrintRoundTrip(t, *m)
+
+	m2, err := binary.DecodeFile("../binary/testdata/hw_rust.wasm")
+	require.NoError(t, err)
+	testPrintRoundTrip(t, m2)
+
+	m3, err := CompileModuleStr(`(module
+  (global (mut externref) (ref.null extern))
+  (func $f (param externref) (result externref i32)
+    (local.get 0) (ref.is_null (local.get 0)))
+  (elem declare func $f))`)
+	require.NoError(t, err)
+	testPrintRoundTrip(t, *m3)
+	m4, err := binary.Decode(binary.Encode(*m3))
+	require.NoError(t, err)
+	testPrintRoundTrip(t, m4)
+}
+
+func TestPrintRoundTripMemory64(t *testing.T) {
+	for _, wat := range []string{
+		`(module (memory i64 1 0x1_0000_0000) (func (drop (i64.load (i64.const 8)))))`,
+		`(module (import "env" "m" (memory i64 1)))`,
+		`(module (memory i64 (data "hi")))`,
+	} {
+		m, err := CompileModuleStr(wat)
+		require.NoError(t, err, wat)
+		sb := &strings.Builder{}
+		require.NoError(t, Print(*m, sb))
+		require.Contains(t, sb.String(), ") i64 1", wat)
+		testPrintRoundTrip(t, *m)
+	}
+}
+
+func testPrintRoundTrip(t *testing.T, m binary.Module) {
+	for _, folded := range []bool{false, true} {
+		sb := &strings.Builder{}
+		require.NoError(t, PrintWithOptions(m, sb, PrintOptions{Folded: folded}))
+		m2, err := CompileModuleStr(sb.String())
+		require.NoError(t, err, sb.String())
+		require.Equal(t, normalizeModule(m), normalizeModule(*m2))
+	}
+}
+
+// removes what the text format can't express,
+// empty slices are replaced by nil and floats by their bits (NaN != NaN)
+func normalizeModule(m binary.Module) binary.Module {
+	m.Magic, m.Version = 0, 0
+	m.Sections, m.CustomSecs = nil, nil
+	types := make([]binary.FuncType, len(m.TypeSec))
+	for i, ft := range m.TypeSec {
+		types[i] = binary.FuncType{
+			ParamTypes:  nilIfEmpty(ft.ParamTypes),
+			ResultTypes: nilIfEmpty(ft.ResultTypes),
+		}
+	}
+	m.TypeSec = types
+	globals := make([]binary.Global, len(m.GlobalSec))
+	for i, g := range m.GlobalSec {
+		globals[i] = binary.Global{Type: g.Type, Expr: normalizeExpr(g.Expr)}
+	}
+	m.GlobalSec = globals
+	elems := make([]binary.Elem, len(m.ElemSec))
+	for i, elem := range m.ElemSec {
+		elems[i] = binary.Elem{Table: elem.Table, Offset: normalizeExpr(elem.Offset)}
+		if len(elem.Init) > 0 {
+			elems[i].Init = elem.Init
+		}
+	}
+	m.ElemSec = elems
+	data := make([]binary.Data, len(m.DataSec))
+	for i, d := range m.DataSec {
+		data[i] = binary.Data{Mem: d.Mem, Offset: normalizeExpr(d.Offset)}
+		if len(d.Init) > 0 {
+			data[i].Init = d.Init
+		}
+	}
+	m.DataSec = data
+	codes := make([]binary.Code, len(m.CodeSec))
+	for i, code := range m.CodeSec {
+		codes[i] = binary.Code{Expr: normalizeExpr(code.Expr)}
+		if len(code.Locals) > 0 {
+			codes[i].Locals = code.Locals
+		}
+	}
+	m.CodeSec = codes
+	return m
+}
+
+func normalizeExpr(expr binary.Expr) binary.Expr {
+	var instrs binary.Expr
+	for _, instr := range expr {
+		instr.Offset = 0
+		switch args := instr.Args.(type) {
+		case binary.BlockArgs:
+			args.RT = nilIfEmpty(args.RT)
+			args.Instrs = normalizeExpr(args.Instrs)
+			instr.Args = args
+		case binary.IfArgs:
+			args.RT = nilIfEmpty(args.RT)
+			args.Instrs1 = normalizeExpr(args.Instrs1)
+			args.Instrs2 = normalizeExpr(args.Instrs2)
+			instr.Args = args
+		case float32:
+			instr.Args = math.Float32bits(args)
+		case float64:
+			instr.Args = math.Float64bits(args)
+		}
+		instrs = append(instrs, instr)
+	}
+	return instrs
+}
+
+func nilIfEmpty(vts []binary.ValType) []binary.ValType {
+	if len(vts) == 0 {
+		return nil
+	}
+	return vts
+}
+
+func TestPrint(t *testing.T) {
+	m, err := CompileModuleStr(`(module
+  (func (param i32) (result i32)
+    (i32.add (local.get 0) (i32.const 1)))
+  (data (i32.const 0) "a\n"))`)
+	require.NoError(t, err)
+
+	m.CustomSecs = []binary.CustomSec{{Name: "name", Data: []byte{
+		0, 2, 1, 'm', // module name
+		1, 5, 1, 0, 2, 'f', ' ', // func names
+		2, 6, 1, 0, 1, 0, 1, 'x', // local names
+	}}}
+
+	sb := &strings.Builder{}
+	require.NoError(t, Print(*m, sb))
+	require.Equal(t, `(module $m
+  (type (;0;) (func (param i32) (result i32)))
+  (func $f_ (;0;) (type 0) (param $x i32) (result i32)
+    local.get $x
+    i32.const 1
+    i32.add)
+  (data (;0;) (i32.const 0) "a\0a"))
+`, sb.String())
+
+	sb.Reset()
+	require.NoError(t, PrintWithOptions(*m, sb, PrintOptions{Folded: true}))
+	require.Contains(t, sb.String(), `
+    (i32.add
+      (local.get $x)
+      (i32.const 1)))
+`)
+}
+
+func TestPrintAnnotations(t *testing.T) {
+	m, err := CompileModuleStr(`(module (@name "m")
+  (@custom "a" (before first) "\01")
+  (func $f (@name "f g") (param (@name "x") i32))
+  (@custom "b" (after func) "c")
+  (@custom "c" ""))`)
+	require.NoError(t, err)
+
+	sb := &strings.Builder{}
+	require.NoError(t, PrintWithOptions(*m, sb, PrintOptions{Annotations: true}))
+	require.Equal(t, `(module $m
+  (type (;0;) (func (param i32)))
+  (func $f_g (@name "f g") (;0;) (type 0) (param $x i32))
+  (@custom "a" (before first) "\01")
+  (@custom "b" (after func) "c")
+  (@custom "c" ""))
+`, sb.String())
+
+	m2, err := CompileModuleStrWithOptions(sb.String(), CompileOptions{Names: true})
+	require.NoError(t, err)
+	require.Equal(t, binary.Encode(*m), binary.Encode(*m2))
+}
+
+func TestPrintFloats(t *testing.T) {
+	for _, s := range []string{"0x1.8p+1", "-0x0p+0", "inf", "-inf", "nan",
+		"-nan", "nan:0x200000", "0x1p-149"} {
+		require.Equal(t, s, f32Str(parseF32(s)))
+	}
+	for _, s := range []string{"0x1.999999999999ap-4", "nan:0x1", "-nan", "0x1p+1023"} {
+		require.Equal(t, s, f64Str(parseF64(s)))
+	}
+}
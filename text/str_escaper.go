@@ -1,6 +1,10 @@
 package text
 
-import "strconv"
+import (
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
 
 func escape(s string) []byte {
 	n := len(s)
@@ -9,28 +13,34 @@ func escape(s string) []byte {
 	for i := 0; i < n; i++ {
 		if s[i] != '\\' {
 			data = append(data, s[i])
-		} else {
-			switch s[i+1] {
-			case 't':
-				data = append(data, '\t')
-			case 'n':
-				data = append(data, '\n')
-			case 'r':
-				data = append(data, '\r')
-			case '"':
-				data = append(data, '"')
-			case '\\':
-				data = append(data, '\\')
-			case 'u':
-				panic("TODO")
-			default:
-				k, _ := strconv.ParseUint(s[i+1:i+3], 16, 32)
-				data = append(data, byte(k))
-				i += 2
-			}
+			continue
+		}
+		i++
+		switch s[i] {
+		case 't':
+			data = append(data, '\t')
+		case 'n':
+			data = append(data, '\n')
+		case 'r':
+			data = append(data, '\r')
+		case '"':
+			data = append(data, '"')
+		case '\'':
+			data = append(data, '\'')
+		case '\\':
+			data = append(data, '\\')
+		case 'u': // \u{hex}
+			end := i + strings.IndexByte(s[i:], '}')
+			r, _ := strconv.ParseUint(s[i+2:end], 16, 32)
+			var buf [utf8.UTFMax]byte
+			data = append(data, buf[:utf8.EncodeRune(buf[:], rune(r))]...)
+			i = end
+		default:
+			k, _ := strconv.ParseUint(s[i:i+2], 16, 8)
+			data = append(data, byte(k))
+			i++
 		}
 	}
 
-	// TODO
 	return data
 }
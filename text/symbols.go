@@ -0,0 +1,190 @@
+package text
+
+import (
+	"sort"
+	"strings"
+)
+
+// Symbol is a definition or a reference of a $name.
+type Symbol struct {
+	Kind   string // "type", "func", "table", "memory", "global", "local", "label" or "module"
+	Name   string
+	Line   int
+	Column int     // 1-based like Diagnostic.Column
+	Def    *Symbol // the definition, itself for definitions, nil if undefined
+	Detail string  // the definition in the text format, e.g. (func $f (param i32))
+}
+
+func (s *Symbol) IsDef() bool {
+	return s.Def == s
+}
+
+// Analysis is the result of compiling a source for editors.
+type Analysis struct {
+	Symbols     []*Symbol // in source order
+	Diagnostics Diagnostics
+}
+
+// Analyze compiles a .wat module or .wast script (see Format) and
+// collects the compile errors and the $names.
+func Analyze(sourceName, src string) *Analysis {
+	r := newSymbolRecorder()
+	var err error
+	if strings.HasSuffix(sourceName, ".wat") {
+		_, err = compileModule(sourceName, src, CompileOptions{}, r)
+	} else {
+		_, err = compileScript(sourceName, src, r)
+	}
+
+	a := &Analysis{Symbols: r.symbols}
+	sort.SliceStable(a.Symbols, func(i, j int) bool {
+		si, sj := a.Symbols[i], a.Symbols[j]
+		return si.Line < sj.Line || si.Line == sj.Line && si.Column < sj.Column
+	})
+	if diags, ok := err.(Diagnostics); ok {
+		a.Diagnostics = diags
+	} else if err != nil {
+		a.Diagnostics = Diagnostics{{File: sourceName, Msg: err.Error()}}
+	}
+	return a
+}
+
+// GetSymbol returns the symbol at the position, or nil.
+func (a *Analysis) GetSymbol(line, column int) *Symbol {
+	for _, s := range a.Symbols {
+		if s.Line == line && column >= s.Column && column < s.Column+len(s.Name) {
+			return s
+		}
+	}
+	return nil
+}
+
+// GetReferences returns the references of a definition.
+func (a *Analysis) GetReferences(def *Symbol) []*Symbol {
+	var refs []*Symbol
+	for _, s := range a.Symbols {
+		if s.Def == def && s != def {
+			refs = append(refs, s)
+		}
+	}
+	return refs
+}
+
+/* recorder */
+
+// records the symbols while parsing, all methods accept a nil recorder
+type symbolRecorder struct {
+	symbols []*Symbol
+	modules map[string]*Symbol // name -> the last module with the name
+	defs    map[string]*Symbol // kind+name -> definition in the current module
+	locals  map[string]*Symbol // name -> param or local of the current function
+	labels  []labelSymbol      // labels of the enclosing blocks
+}
+
+type labelSymbol struct {
+	def   *Symbol
+	depth int
+}
+
+func newSymbolRecorder() *symbolRecorder {
+	return &symbolRecorder{modules: map[string]*Symbol{}}
+}
+
+func (r *symbolRecorder) enterModule() {
+	if r != nil {
+		r.defs = map[string]*Symbol{}
+	}
+}
+
+func (r *symbolRecorder) enterFunc() {
+	if r != nil {
+		r.locals = map[string]*Symbol{}
+		r.labels = nil
+	}
+}
+
+func (r *symbolRecorder) define(kind string, name token, detail string) {
+	if r == nil || name.kind != tokName {
+		return
+	}
+	s := r.add(kind, name)
+	s.Def = s
+	s.Detail = detail
+	switch kind {
+	case "module":
+		r.modules[name.text] = s
+	case "local":
+		r.locals[name.text] = s
+	default:
+		r.defs[kind+name.text] = s
+	}
+}
+
+// the detail of module fields is known after defining their names
+func (r *symbolRecorder) describe(kind string, name token, detail string) {
+	if r == nil || name.kind != tokName {
+		return
+	}
+	if s := r.defs[kind+name.text]; s != nil && s.Line == name.line && s.Column == name.column+1 {
+		s.Detail = detail
+	}
+}
+
+// labels are defined at the depth of their blocks
+func (r *symbolRecorder) defineLabel(name token, detail string, depth int) {
+	if r == nil || name.kind != tokName {
+		return
+	}
+	s := r.add("label", name)
+	s.Def = s
+	s.Detail = detail
+	r.exitLabels(depth - 1)
+	r.labels = append(r.labels, labelSymbol{def: s, depth: depth})
+}
+
+func (r *symbolRecorder) use(kind string, _var token) {
+	if r == nil || _var.kind != tokName {
+		return
+	}
+	s := r.add(kind, _var)
+	switch kind {
+	case "module":
+		s.Def = r.modules[_var.text]
+	case "local":
+		s.Def = r.locals[_var.text]
+	default:
+		s.Def = r.defs[kind+_var.text]
+	}
+}
+
+func (r *symbolRecorder) useLabel(_var token, depth int) {
+	if r == nil || _var.kind != tokName {
+		return
+	}
+	s := r.add("label", _var)
+	r.exitLabels(depth)
+	for i := len(r.labels) - 1; i >= 0; i-- {
+		if r.labels[i].def.Name == _var.text {
+			s.Def = r.labels[i].def
+			break
+		}
+	}
+}
+
+// forgets the labels of the blocks deeper than depth
+func (r *symbolRecorder) exitLabels(depth int) {
+	for len(r.labels) > 0 && r.labels[len(r.labels)-1].depth > depth {
+		r.labels = r.labels[:len(r.labels)-1]
+	}
+}
+
+func (r *symbolRecorder) add(kind string, tok token) *Symbol {
+	s := &Symbol{
+		Kind:   kind,
+		Name:   tok.text,
+		Line:   tok.line,
+		Column: tok.column + 1,
+	}
+	r.symbols = append(r.symbols, s)
+	return s
+}
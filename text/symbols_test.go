@@ -0,0 +1,63 @@
+package text
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestAnalyze(t *testing.T) {
+	a := Analyze("x.wat", `(module
+  (type $t (func (param i32)))
+  (global $g (mut i32) (i32.const 0))
+  (func $f (type $t) (param $x i32) (local $y i32)
+    block $b
+      loop $b
+        br $b
+      end
+      br $b
+    end
+    (local.set $y (local.get $x))
+    (global.set $g (local.get $y))
+    (call $f (i32.const 1)))
+  (export "f" (func $f)))`)
+	require.Empty(t, a.Diagnostics)
+
+	f := a.GetSymbol(4, 10)
+	require.NotNil(t, f)
+	require.Equal(t, "$f", f.Name)
+	require.True(t, f.IsDef())
+	require.Equal(t, "(func $f (param i32))", f.Detail)
+	refs := a.GetReferences(f)
+	require.Len(t, refs, 2)
+	require.Equal(t, 13, refs[0].Line)
+	require.Equal(t, 14, refs[1].Line)
+
+	typ := a.GetSymbol(4, 18)
+	require.Equal(t, "type", typ.Kind)
+	require.Equal(t, 2, typ.Def.Line)
+
+	loopBr := a.GetSymbol(7, 12)
+	require.Equal(t, 6, loopBr.Def.Line)
+	blockBr := a.GetSymbol(9, 10)
+	require.Equal(t, 5, blockBr.Def.Line)
+
+	x := a.GetSymbol(11, 30)
+	require.Equal(t, "local", x.Kind)
+	require.Equal(t, "(param $x i32)", x.Def.Detail)
+
+	require.Nil(t, a.GetSymbol(1, 1))
+}
+
+func TestAnalyzeErrors(t *testing.T) {
+	a := Analyze("x.wast", `(module $m (func $f (call $g)))
+(register "m" $m)
+(assert_return (invoke $m "f"))`)
+	require.NotEmpty(t, a.Diagnostics)
+	require.Equal(t, 1, a.Diagnostics[0].Line)
+
+	m := a.GetSymbol(2, 15)
+	require.Equal(t, "module", m.Kind)
+	require.Equal(t, 1, m.Def.Line)
+	require.Len(t, a.GetReferences(m.Def), 2)
+}
@@ -0,0 +1,41 @@
+(module
+  (global f32 (f32.const 0x1p128))
+)
+(;;
+Obtained from string:2:26: error: constant out of range
+  (global f32 (f32.const 0x1p128))
+                         ^^^^^^^
+;;)
+
+;;------------------------------;;
+
+(module
+  (global f32 (f32.const nan:0x80_0000))
+)
+(;;
+Obtained from string:2:26: error: constant out of range
+  (global f32 (f32.const nan:0x80_0000))
+                         ^^^^^^^^^^^^^
+;;)
+
+;;------------------------------;;
+
+(module
+  (global i32 (i32.const 0x1_0000_0000))
+)
+(;;
+Obtained from string:2:26: error: constant out of range
+  (global i32 (i32.const 0x1_0000_0000))
+                         ^^^^^^^^^^^^^
+;;)
+
+;;------------------------------;;
+
+(module
+  (global f32 (f32.const 1.0_))
+)
+(;;
+Obtained from string:2:26: error: unexpected token "1.0_", expected number
+  (global f32 (f32.const 1.0_))
+                         ^^^^
+;;)
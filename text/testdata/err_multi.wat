@@ -0,0 +1,17 @@
+(module
+  (func (call $f))
+  (global f32 (f32.const 0x1p128))
+  (func (export "ok"))
+  (start $main)
+)
+(;;
+err.wat:2:15: error: undefined function variable "$f"
+  (func (call $f))
+              ^^
+err.wat:3:26: error: constant out of range
+  (global f32 (f32.const 0x1p128))
+                         ^^^^^^^
+err.wat:5:10: error: undefined function variable "$main"
+  (start $main)
+         ^^^^^
+;;)
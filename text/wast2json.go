@@ -0,0 +1,352 @@
+package text
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/zxh0/wasm.go/binary"
+)
+
+// https://github.com/WebAssembly/wabt/blob/main/docs/wast2json.md
+type jsonCommand struct {
+	Type       string       `json:"type"`
+	Line       int          `json:"line"`
+	Name       string       `json:"name,omitempty"`
+	As         string       `json:"as,omitempty"`
+	FileName   string       `json:"filename,omitempty"`
+	Action     *jsonAction  `json:"action,omitempty"`
+	Text       string       `json:"text,omitempty"`
+	ModuleType string       `json:"module_type,omitempty"`
+	Expected   *[]jsonValue `json:"expected,omitempty"`
+}
+
+type jsonAction struct {
+	Type   string      `json:"type"`
+	Module string      `json:"module,omitempty"`
+	Field  string      `json:"field"`
+	Args   []jsonValue `json:"args"`
+}
+
+type jsonValue struct {
+	Type     string      `json:"type"`
+	LaneType string      `json:"lane_type,omitempty"`
+	Value    interface{} `json:"value,omitempty"` // string or []string
+}
+
+type jsonWriter struct {
+	baseName string
+	files    map[string][]byte
+	cmds     []jsonCommand
+	modules  map[string]*binary.Module
+	module   *binary.Module
+}
+
+// ScriptToJSON converts s to the JSON format of WABT's wast2json.
+// Modules are written to files named after jsonFile, which are
+// returned along with the JSON.
+func ScriptToJSON(s *Script, sourceFile, jsonFile string) (
+	data []byte, files map[string][]byte, err error) {
+
+	w := &jsonWriter{
+		baseName: strings.TrimSuffix(filepath.Base(jsonFile), filepath.Ext(jsonFile)),
+		files:    map[string][]byte{},
+		modules:  map[string]*binary.Module{},
+	}
+	for _, cmd := range s.Cmds {
+		if err = w.writeCmd(cmd); err != nil {
+			return nil, nil, err
+		}
+	}
+	data, err = w.encode(sourceFile)
+	return data, w.files, err
+}
+
+func (w *jsonWriter) encode(sourceFile string) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	srcName, _ := json.Marshal(filepath.Base(sourceFile))
+	fmt.Fprintf(buf, "{\"source_filename\": %s,\n \"commands\": [", srcName)
+	for i, cmd := range w.cmds {
+		data, err := json.Marshal(cmd)
+		if err != nil {
+			return nil, err
+		}
+		if i > 0 {
+			buf.WriteString(",")
+		}
+		buf.WriteString("\n  ")
+		buf.Write(data)
+	}
+	buf.WriteString("]}\n")
+	return buf.Bytes(), nil
+}
+
+func (w *jsonWriter) writeCmd(cmd interface{}) error {
+	switch x := cmd.(type) {
+	case *Module, *BinaryModule, *QuotedModule:
+		return w.writeModule(x)
+	case *Register:
+		w.cmds = append(w.cmds, jsonCommand{
+			Type: "register",
+			Line: x.Line,
+			Name: x.Name,
+			As:   x.ModuleName,
+		})
+		return nil
+	case *Action:
+		return w.writeAction(x)
+	case *Assertion:
+		return w.writeAssertion(x)
+	case *Meta:
+		return fmt.Errorf("line %d: meta commands are not supported", x.Line)
+	default:
+		panic("unreachable")
+	}
+}
+
+func (w *jsonWriter) writeModule(m interface{}) error {
+	var module *binary.Module
+	var line int
+	var name string
+	switch x := m.(type) {
+	case *Module:
+		module, line, name = x.Module, x.Line, x.Name
+	case *BinaryModule:
+		_m, err := binary.Decode(x.Data)
+		if err != nil {
+			return fmt.Errorf("line %d: %s", x.Line, err.Error())
+		}
+		module, line, name = &_m, x.Line, x.Name
+	case *QuotedModule:
+		_m, err := x.Compile()
+		if err != nil {
+			return fmt.Errorf("line %d: %s", x.Line, err.Error())
+		}
+		module, line, name = _m, x.Line, x.Name
+	}
+
+	w.module = module
+	if name != "" {
+		w.modules[name] = module
+	}
+	w.cmds = append(w.cmds, jsonCommand{
+		Type:     "module",
+		Line:     line,
+		Name:     name,
+		FileName: w.addFile(".wasm", binary.Encode(*module)),
+	})
+	return nil
+}
+
+func (w *jsonWriter) writeAction(a *Action) error {
+	action, expected, err := w.getAction(a)
+	if err != nil {
+		return err
+	}
+	w.cmds = append(w.cmds, jsonCommand{
+		Type:     "action",
+		Line:     a.Line,
+		Action:   action,
+		Expected: &expected,
+	})
+	return nil
+}
+
+func (w *jsonWriter) writeAssertion(a *Assertion) error {
+	cmd := jsonCommand{
+		Type: assertionTypes[a.Kind],
+		Line: a.Line,
+		Text: a.Failure,
+	}
+	if a.Action == nil {
+		if a.Kind == AssertTrap {
+			cmd.Type = "assert_uninstantiable"
+		}
+		cmd.FileName, cmd.ModuleType = w.addModuleFile(a.Module)
+		w.cmds = append(w.cmds, cmd)
+		return nil
+	}
+
+	action, expected, err := w.getAction(a.Action)
+	if err != nil {
+		return err
+	}
+	cmd.Action = action
+	cmd.Expected = &expected
+	if a.Kind == AssertReturn {
+		results := make([]jsonValue, len(a.Results))
+		for i, r := range a.Results {
+			results[i] = getResultValue(r)
+		}
+		cmd.Expected = &results
+	}
+	w.cmds = append(w.cmds, cmd)
+	return nil
+}
+
+var assertionTypes = [...]string{
+	AssertReturn:     "assert_return",
+	AssertTrap:       "assert_trap",
+	AssertExhaustion: "assert_exhaustion",
+	AssertMalformed:  "assert_malformed",
+	AssertInvalid:    "assert_invalid",
+	AssertUnlinkable: "assert_unlinkable",
+}
+
+// writes the module of an assertion as is, it may not even compile
+func (w *jsonWriter) addModuleFile(m interface{}) (filename, moduleType string) {
+	switch x := m.(type) {
+	case *Module:
+		return w.addFile(".wasm", binary.Encode(*x.Module)), "binary"
+	case *BinaryModule:
+		return w.addFile(".wasm", x.Data), "binary"
+	case *QuotedModule:
+		return w.addFile(".wat", []byte(x.Text)), "text"
+	default:
+		panic("unreachable")
+	}
+}
+
+func (w *jsonWriter) addFile(ext string, data []byte) string {
+	filename := w.baseName + "." + strconv.Itoa(len(w.files)) + ext
+	w.files[filename] = data
+	return filename
+}
+
+// returns the action and the types of its results
+func (w *jsonWriter) getAction(a *Action) (*jsonAction, []jsonValue, error) {
+	m := w.module
+	if a.ModuleName != "" {
+		m = w.modules[a.ModuleName]
+	}
+	if m == nil {
+		return nil, nil, fmt.Errorf("line %d: unknown module: %q",
+			a.Line, a.ModuleName)
+	}
+
+	action := &jsonAction{
+		Module: a.ModuleName,
+		Field:  a.ItemName,
+		Args:   make([]jsonValue, 0, len(a.Args)),
+	}
+	var resultTypes []binary.ValType
+	var err error
+	switch a.Kind {
+	case ActionInvoke:
+		action.Type = "invoke"
+		resultTypes, err = getExportResultTypes(m, a.ItemName, binary.ExportTagFunc)
+	case ActionGet:
+		action.Type = "get"
+		resultTypes, err = getExportResultTypes(m, a.ItemName, binary.ExportTagGlobal)
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("line %d: %s", a.Line, err.Error())
+	}
+
+	for _, arg := range a.Args {
+		action.Args = append(action.Args, getResultValue(arg))
+	}
+	expected := make([]jsonValue, len(resultTypes))
+	for i, vt := range resultTypes {
+		expected[i] = jsonValue{Type: getJSONType(vt)}
+	}
+	return action, expected, nil
+}
+
+// result types of an exported func, or type of an exported global
+func getExportResultTypes(m *binary.Module, name string,
+	tag byte) ([]binary.ValType, error) {
+
+	for _, exp := range m.ExportSec {
+		if exp.Name != name || exp.Desc.Tag != tag {
+			continue
+		}
+		idx := int(exp.Desc.Idx)
+		for _, imp := range m.ImportSec {
+			if imp.Desc.Tag != tag {
+				continue
+			}
+			if idx == 0 {
+				if tag == binary.ImportTagFunc {
+					return m.TypeSec[imp.Desc.FuncType].ResultTypes, nil
+				}
+				return []binary.ValType{imp.Desc.Global.ValType}, nil
+			}
+			idx--
+		}
+		if tag == binary.ExportTagFunc && idx < len(m.FuncSec) {
+			return m.TypeSec[m.FuncSec[idx]].ResultTypes, nil
+		}
+		if tag == binary.ExportTagGlobal && idx < len(m.GlobalSec) {
+			return []binary.ValType{m.GlobalSec[idx].Type.ValType}, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown export: %q", name)
+}
+
+func getJSONType(vt binary.ValType) string {
+	if vt.IsRef() && vt != binary.ValTypeExternRef {
+		return "funcref"
+	}
+	return vt.String()
+}
+
+func getResultValue(r Result) jsonValue {
+	switch r.Kind {
+	case ResultConst:
+		return jsonValue{Type: r.Type, Value: formatBits(r.Value)}
+	case ResultCanonicalNaN:
+		return jsonValue{Type: r.Type, Value: "nan:canonical"}
+	case ResultArithmeticNaN:
+		return jsonValue{Type: r.Type, Value: "nan:arithmetic"}
+	case ResultRefNull:
+		if r.Type == "extern" {
+			return jsonValue{Type: "externref", Value: "null"}
+		}
+		return jsonValue{Type: "funcref", Value: "null"}
+	case ResultRefFunc:
+		return jsonValue{Type: "funcref"}
+	case ResultRefExtern:
+		return jsonValue{Type: "externref", Value: formatBits(r.Value)}
+	case ResultV128:
+		lanes := make([]string, len(r.Lanes))
+		for i, lane := range r.Lanes {
+			if lane.Kind == ResultConst && lane.Type == "i8" {
+				lane.Value = int8(lane.Value.(int32))
+			} else if lane.Kind == ResultConst && lane.Type == "i16" {
+				lane.Value = int16(lane.Value.(int32))
+			}
+			lanes[i] = getResultValue(lane).Value.(string)
+		}
+		laneType := r.Type[:strings.IndexByte(r.Type, 'x')]
+		return jsonValue{Type: "v128", LaneType: laneType, Value: lanes}
+	default:
+		panic("unreachable")
+	}
+}
+
+// numbers are written as unsigned decimals, floats as their bits
+func formatBits(val interface{}) string {
+	switch x := val.(type) {
+	case int8:
+		return strconv.FormatUint(uint64(uint8(x)), 10)
+	case int16:
+		return strconv.FormatUint(uint64(uint16(x)), 10)
+	case int32:
+		return strconv.FormatUint(uint64(uint32(x)), 10)
+	case int64:
+		return strconv.FormatUint(uint64(x), 10)
+	case uint32:
+		return strconv.FormatUint(uint64(x), 10)
+	case float32:
+		return strconv.FormatUint(uint64(math.Float32bits(x)), 10)
+	case float64:
+		return strconv.FormatUint(math.Float64bits(x), 10)
+	default:
+		panic(fmt.Errorf("unexpected value: %v", val))
+	}
+}
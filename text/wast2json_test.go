@@ -0,0 +1,50 @@
+package text
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zxh0/wasm.go/binary"
+)
+
+func TestScriptToJSON(t *testing.T) {
+	s, err := CompileScriptStr(`(module $M
+  (func (export "add") (param i32 i32) (result i32) (i32.add (local.get 0) (local.get 1)))
+  (func (export "trap") (result f32) unreachable))
+(register "M" $M)
+(assert_return (invoke "add" (i32.const -1) (i32.const 2)) (i32.const 1))
+(assert_trap (invoke $M "trap") "unreachable")
+(assert_malformed (module quote "(func") "unexpected")
+(assert_trap (module (func unreachable) (start 0)) "unreachable")`)
+	require.NoError(t, err)
+
+	data, files, err := ScriptToJSON(s, "dir/x.wast", "out/x.json")
+	require.NoError(t, err)
+	require.Len(t, files, 3)
+	require.Equal(t, []byte("(func"), files["x.1.wat"])
+	m, err := binary.Decode(files["x.0.wasm"])
+	require.NoError(t, err)
+	require.Len(t, m.ExportSec, 2)
+
+	var script struct {
+		SourceFilename string                   `json:"source_filename"`
+		Commands       []map[string]interface{} `json:"commands"`
+	}
+	require.NoError(t, json.Unmarshal(data, &script))
+	require.Equal(t, "x.wast", script.SourceFilename)
+	cmds := make([]string, len(script.Commands))
+	for i, cmd := range script.Commands {
+		data, err := json.Marshal(cmd)
+		require.NoError(t, err)
+		cmds[i] = string(data)
+	}
+	require.Equal(t, []string{
+		`{"filename":"x.0.wasm","line":1,"name":"$M","type":"module"}`,
+		`{"as":"M","line":4,"name":"$M","type":"register"}`,
+		`{"action":{"args":[{"type":"i32","value":"4294967295"},{"type":"i32","value":"2"}],"field":"add","type":"invoke"},"expected":[{"type":"i32","value":"1"}],"line":5,"type":"assert_return"}`,
+		`{"action":{"args":[],"field":"trap","module":"$M","type":"invoke"},"expected":[{"type":"f32"}],"line":6,"text":"unreachable","type":"assert_trap"}`,
+		`{"filename":"x.1.wat","line":7,"module_type":"text","text":"unexpected","type":"assert_malformed"}`,
+		`{"filename":"x.2.wasm","line":8,"module_type":"binary","text":"unreachable","type":"assert_uninstantiable"}`,
+	}, cmds)
+}
@@ -1,12 +1,22 @@
 package text
 
-import "github.com/zxh0/wasm.go/binary"
+import (
+	"strings"
+
+	"github.com/zxh0/wasm.go/binary"
+)
 
 const (
 	ActionInvoke = 1
 	ActionGet    = 2
 )
 
+const (
+	MetaScript = 1
+	MetaInput  = 2
+	MetaOutput = 3
+)
+
 const (
 	AssertReturn     = 1
 	AssertTrap       = 2
@@ -17,43 +27,93 @@ const (
 )
 
 // https://github.com/WebAssembly/spec/tree/master/interpreter#scripts
+// Line is the line number of a command in the source.
 type Script struct {
 	Cmds []interface{}
 }
 
 type Module struct {
+	Line   int
 	Name   string
 	Module *binary.Module
 }
 type BinaryModule struct {
+	Line int
 	Name string
 	Data []byte
 }
 type QuotedModule struct {
+	Line int
 	Name string
 	Text string
 }
 
 type Register struct {
+	Line       int
 	ModuleName string
 	Name       string
 }
 
 type Action struct {
+	Line       int
 	Kind       byte
 	ModuleName string
 	ItemName   string
-	Expr       []binary.Instruction
+	Args       []Result // of invoke, constants only
 }
 
 type Assertion struct {
+	Line    int
 	Kind    byte
 	Action  *Action
-	Result  []binary.Instruction
+	Results []Result
 	Module  interface{}
 	Failure string
 }
 
+const (
+	ResultConst         = 1 // (t.const c)
+	ResultCanonicalNaN  = 2 // (t.const nan:canonical)
+	ResultArithmeticNaN = 3 // (t.const nan:arithmetic)
+	ResultRefNull       = 4 // (ref.null ht)
+	ResultRefFunc       = 5 // (ref.func)
+	ResultRefExtern     = 6 // (ref.extern n)
+	ResultV128          = 7 // (v128.const shape lane*)
+)
+
+// expected value of assert_return
+type Result struct {
+	Kind  byte
+	Type  string      // i32, i64, f32, f64 or v128 shape (i8x16, ..., f64x2)
+	Value interface{} // int32, int64, float32, float64, or uint32 of ref.extern
+	Lanes []Result    // of v128, each is a const or a NaN pattern
+}
+
 type Meta struct {
-	// TODO
+	Line     int
+	Kind     byte
+	Name     string
+	Script   *Script // (script)
+	FileName string  // (input) & (output), optional for (output)
+}
+
+// compiles the quoted text, which is either a module or its fields
+func (m *QuotedModule) Compile() (*binary.Module, error) {
+	src := m.Text
+	if !isModuleText(src) {
+		src = "(module " + src + ")"
+	}
+	return CompileModuleStr(src)
+}
+
+// reports whether s is a "(module ...)" rather than a list of module fields
+func isModuleText(s string) bool {
+	s = strings.TrimSpace(s)
+	if !strings.HasPrefix(s, "(") {
+		return false
+	}
+	s = strings.TrimSpace(s[1:])
+	rest := strings.TrimPrefix(s, "module")
+	return len(rest) < len(s) &&
+		(rest == "" || strings.ContainsAny(rest[:1], " \t\r\n$()"))
 }
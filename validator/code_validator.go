@@ -2,12 +2,13 @@ package validator
 
 import (
 	"fmt"
+	"math"
 
 	"github.com/zxh0/wasm.go/binary"
 )
 
 /*
-type val_type = I32 | I64 | F32 | F64
+type val_type = I32 | I64 | F32 | F64 | Ref
 type opd_stack = stack(val_type | Unknown)
 type ctrl_stack = stack(ctrl_frame)
 type ctrl_frame = {
@@ -27,7 +28,7 @@ const (
 	F32 = binary.ValTypeF32
 )
 
-type valType = byte
+type valType = binary.ValType
 type opdStack []valType
 type ctrlStack []ctrlFrame
 type ctrlFrame struct {
@@ -48,9 +49,10 @@ type codeValidator struct {
 	localCount int
 	maxOpds    int
 	instrPath  map[int]string // depth -> opname
+	instr      binary.Instruction
 }
 
-func validateCode(mv *moduleValidator,
+func validateCode(mv *moduleValidator, fIdx int,
 	code binary.Code, ft binary.FuncType) (err error, maxOpds int) {
 
 	cv := &codeValidator{
@@ -62,7 +64,8 @@ func validateCode(mv *moduleValidator,
 		if _err := recover(); _err != nil {
 			switch x := _err.(type) {
 			case error:
-				err = x
+				err = fmt.Errorf("%s: %s",
+					code.Location(fIdx, cv.instr), x.Error())
 			default:
 				panic(_err)
 			}
@@ -139,7 +142,7 @@ func (cv *codeValidator) popOpdOf(expect valType) valType {
 	if expect == Unknown {
 		return actual
 	}
-	if actual != expect {
+	if !cv.mv.isSubtype(actual, expect) {
 		cv.error("type mismatch") // TODO
 	}
 	return actual
@@ -161,6 +164,15 @@ func (cv *codeValidator) popOpds(types []valType) {
 	}
 }
 
+// pops a reference of any type
+func (cv *codeValidator) popRef() valType {
+	vt := cv.popOpd()
+	if vt != Unknown && !vt.IsRef() {
+		cv.error("type mismatch")
+	}
+	return vt
+}
+
 func (cv *codeValidator) pushI32() { cv.pushOpd(I32) }
 func (cv *codeValidator) pushI64() { cv.pushOpd(I64) }
 func (cv *codeValidator) pushF32() { cv.pushOpd(F32) }
@@ -231,6 +243,12 @@ func (cv *codeValidator) validateCode(
 	cv.pushOpds(ft.ParamTypes)
 	cv.localCount = len(ft.ParamTypes)
 	for _, local := range code.Locals {
+		if err := cv.mv.checkValType(local.Type); err != nil {
+			cv.error(err.Error())
+		}
+		if local.Type.IsRef() && !local.Type.IsNullable() {
+			cv.error("uninitialized local")
+		}
 		for i := 0; i < int(local.N); i++ {
 			cv.pushOpd(local.Type)
 			cv.localCount++
@@ -245,12 +263,25 @@ func (cv *codeValidator) validateExpr(expr []binary.Instruction) {
 	depth := len(cv.instrPath)
 	for _, instr := range expr {
 		cv.instrPath[depth] = instr.GetOpname()
+		cv.instr = instr
 		cv.validateInstr(instr)
 	}
 	delete(cv.instrPath, depth)
 }
 
 func (cv *codeValidator) validateInstr(instr binary.Instruction) {
+	if err := cv.mv.features.Check(instr.GetOpname(),
+		binary.OpcodeFeatures(instr.Opcode)); err != nil {
+		cv.error(err.Error())
+	}
+
+	switch instr.Opcode {
+	case binary.Block, binary.Loop:
+		cv.checkBlockType(instr.Args.(binary.BlockArgs).RT)
+	case binary.If:
+		cv.checkBlockType(instr.Args.(binary.IfArgs).RT)
+	}
+
 	switch instr.Opcode {
 	case binary.Unreachable:
 		cv.unreachable()
@@ -335,12 +366,57 @@ func (cv *codeValidator) validateInstr(instr binary.Instruction) {
 		cv.popI32()
 		cv.popOpds(ft.ParamTypes)
 		cv.pushOpds(ft.ResultTypes)
+	case binary.CallRef:
+		ft := cv.popFuncRef(instr.Args.(uint32))
+		cv.popOpds(ft.ParamTypes)
+		cv.pushOpds(ft.ResultTypes)
+	case binary.ReturnCallRef:
+		ft := cv.popFuncRef(instr.Args.(uint32))
+		rt := cv.getCtrl(len(cv.ctrls) - 1).labelTypes
+		if len(ft.ResultTypes) != len(rt) {
+			cv.error("type mismatch")
+		}
+		for i, vt := range ft.ResultTypes {
+			if !cv.mv.isSubtype(vt, rt[i]) {
+				cv.error("type mismatch")
+			}
+		}
+		cv.popOpds(ft.ParamTypes)
+		cv.unreachable()
+	case binary.BrOnNull:
+		n := int(instr.Args.(uint32))
+		if len(cv.ctrls) <= n {
+			cv.error("unknown label")
+		}
+		rt := cv.popRef()
+		cv.popOpds(cv.getCtrl(n).labelTypes)
+		cv.pushOpds(cv.getCtrl(n).labelTypes)
+		cv.pushOpd(rt.AsNonNull())
+	case binary.BrOnNonNull:
+		n := int(instr.Args.(uint32))
+		if len(cv.ctrls) <= n {
+			cv.error("unknown label")
+		}
+		rt := cv.popRef()
+		labelTypes := cv.getCtrl(n).labelTypes
+		if len(labelTypes) == 0 {
+			cv.error("type mismatch")
+		}
+		last := len(labelTypes) - 1
+		if rt != Unknown && !cv.mv.isSubtype(rt.AsNonNull(), labelTypes[last]) {
+			cv.error("type mismatch")
+		}
+		cv.popOpds(labelTypes[:last])
+		cv.pushOpds(labelTypes[:last])
 	case binary.Drop:
 		cv.popOpd()
 	case binary.Select:
 		cv.popI32()
 		t1 := cv.popOpd()
 		t2 := cv.popOpdOf(t1)
+		if t1.IsRef() || t2.IsRef() {
+			cv.error("type mismatch")
+		}
 		cv.pushOpd(t2)
 	case binary.LocalGet:
 		n := int(instr.Args.(uint32))
@@ -415,11 +491,11 @@ func (cv *codeValidator) validateInstr(instr binary.Instruction) {
 		cv.i64Store(instr.Args, 32)
 	case binary.MemorySize:
 		cv.checkMem()
-		cv.pushI32()
+		cv.pushOpd(cv.addrType())
 	case binary.MemoryGrow:
 		cv.checkMem()
-		cv.popI32()
-		cv.pushI32()
+		cv.popOpdOf(cv.addrType())
+		cv.pushOpd(cv.addrType())
 	case binary.I32Const:
 		cv.pushI32()
 	case binary.I64Const:
@@ -560,11 +636,56 @@ func (cv *codeValidator) validateInstr(instr binary.Instruction) {
 	case binary.F64ReinterpretI64:
 		cv.popI64()
 		cv.pushF64()
+	case binary.I32Extend8S, binary.I32Extend16S:
+		cv.popI32()
+		cv.pushI32()
+	case binary.I64Extend8S, binary.I64Extend16S, binary.I64Extend32S:
+		cv.popI64()
+		cv.pushI64()
+	case binary.RefNull:
+		vt := instr.Args.(binary.ValType)
+		if err := cv.mv.checkValType(vt); err != nil {
+			cv.error(err.Error())
+		}
+		cv.pushOpd(vt)
+	case binary.RefIsNull:
+		cv.popRef()
+		cv.pushI32()
+	case binary.RefFunc:
+		fIdx := instr.Args.(uint32)
+		ftIdx, ok := cv.mv.getFuncTypeIdx(int(fIdx))
+		if !ok {
+			cv.errorf("unknown function: %d", fIdx)
+		}
+		if !cv.mv.refs[fIdx] {
+			cv.errorf("undeclared function reference: %d", fIdx)
+		}
+		cv.pushOpd(binary.RefType(false, ftIdx))
+	case binary.RefAsNonNull:
+		cv.pushOpd(cv.popRef().AsNonNull())
 	default:
 		cv.error("")
 	}
 }
 
+func (cv *codeValidator) checkBlockType(rt binary.BlockType) {
+	for _, vt := range rt {
+		if err := cv.mv.checkValType(vt); err != nil {
+			cv.error(err.Error())
+		}
+	}
+}
+
+/* function references */
+
+func (cv *codeValidator) popFuncRef(ftIdx uint32) binary.FuncType {
+	if int(ftIdx) >= cv.mv.getTypeCount() {
+		cv.error("unknown type")
+	}
+	cv.popOpdOf(binary.RefType(true, ftIdx))
+	return cv.mv.module.TypeSec[ftIdx]
+}
+
 /* memory */
 
 func (cv *codeValidator) i32Load(args interface{}, bitWidth int) {
@@ -596,20 +717,25 @@ func (cv *codeValidator) f64Store(args interface{}, bitWidth int) {
 func (cv *codeValidator) load(vt binary.ValType, bitWidth int, args interface{}) {
 	cv.checkMem()
 	cv.checkAlign(bitWidth, args)
-	cv.popI32()
+	cv.checkOffset(args)
+	cv.popOpdOf(cv.addrType())
 	cv.pushOpd(vt)
 }
 func (cv *codeValidator) store(vt binary.ValType, bitWidth int, args interface{}) {
 	cv.checkMem()
 	cv.checkAlign(bitWidth, args)
+	cv.checkOffset(args)
 	cv.popOpdOf(vt)
-	cv.popI32()
+	cv.popOpdOf(cv.addrType())
 }
 func (cv *codeValidator) checkMem() {
 	if cv.mv.getMemCount() == 0 {
 		cv.error("unknown memory")
 	}
 }
+func (cv *codeValidator) addrType() valType {
+	return cv.mv.getAddrType(0)
+}
 func (cv *codeValidator) checkAlign(bitWidth int, args interface{}) {
 	align := args.(binary.MemArg).Align
 	if 1<<align > bitWidth/8 {
@@ -617,6 +743,12 @@ func (cv *codeValidator) checkAlign(bitWidth int, args interface{}) {
 			bitWidth/8)
 	}
 }
+func (cv *codeValidator) checkOffset(args interface{}) {
+	offset := args.(binary.MemArg).Offset
+	if cv.addrType() == I32 && offset > math.MaxUint32 {
+		cv.errorf("offset out of range: %d", offset)
+	}
+}
 
 /* helper */
 
@@ -6,18 +6,31 @@ import (
 	"github.com/zxh0/wasm.go/binary"
 )
 
+type Options struct {
+	Features binary.Features
+}
+
 type moduleValidator struct {
 	module           binary.Module
+	features         binary.Features
 	importedFuncs    []binary.Import
 	importedTables   []binary.Import
 	importedMemories []binary.Import
 	importedGlobals  []binary.Import
+	memTypes         []binary.MemType
 	globalTypes      []binary.GlobalType
+	refs             map[uint32]bool // functions which ref.func may refer to
 	maxOperandStacks []int
 }
 
 func Validate(module binary.Module) (err error, maxOperandStacks []int) {
-	v := &moduleValidator{module: module}
+	return ValidateWithOptions(module, Options{Features: binary.DefaultFeatures})
+}
+
+func ValidateWithOptions(module binary.Module,
+	opts Options) (err error, maxOperandStacks []int) {
+
+	v := &moduleValidator{module: module, features: opts.Features}
 	if err = v.validateTypeSec(); err != nil {
 		return
 	}
@@ -45,6 +58,7 @@ func Validate(module binary.Module) (err error, maxOperandStacks []int) {
 	if err = v.validateElemSec(); err != nil {
 		return
 	}
+	v.initRefs()
 	if err = v.validateCodeSec(); err != nil {
 		return
 	}
@@ -56,8 +70,16 @@ func Validate(module binary.Module) (err error, maxOperandStacks []int) {
 
 func (v *moduleValidator) validateTypeSec() error {
 	for i, ft := range v.module.TypeSec {
+		if err := v.checkValTypes(ft.ParamTypes); err != nil {
+			return fmt.Errorf("type[%d]: %s", i, err.Error())
+		}
+		if err := v.checkValTypes(ft.ResultTypes); err != nil {
+			return fmt.Errorf("type[%d]: %s", i, err.Error())
+		}
 		if len(ft.ResultTypes) > 1 {
-			return fmt.Errorf("type[%d]: invalid result arity", i)
+			if err := v.features.Check("multiple results", binary.FeatureMultiValue); err != nil {
+				return fmt.Errorf("type[%d]: %s", i, err.Error())
+			}
 		}
 	}
 	return nil
@@ -84,11 +106,15 @@ func (v *moduleValidator) validateImportSec() error {
 				return fmt.Errorf("multiple memories")
 			}
 			v.importedMemories = append(v.importedMemories, imp)
-			if err := validateMemoryType(imp.Desc.Mem); err != nil {
+			v.memTypes = append(v.memTypes, imp.Desc.Mem)
+			if err := v.validateMemoryType(imp.Desc.Mem); err != nil {
 				return fmt.Errorf("import[%d]: %s", i, err.Error())
 			}
 		case binary.ImportTagGlobal:
 			v.importedGlobals = append(v.importedGlobals, imp)
+			if err := v.checkValType(imp.Desc.Global.ValType); err != nil {
+				return fmt.Errorf("import[%d]: %s", i, err.Error())
+			}
 			v.globalTypes = append(v.globalTypes, imp.Desc.Global)
 		}
 	}
@@ -118,14 +144,19 @@ func (v *moduleValidator) validateMemSec() error {
 		if i+v.getImportedMemCount() > 0 {
 			return fmt.Errorf("multiple memories")
 		}
-		if err := validateMemoryType(mem); err != nil {
+		if err := v.validateMemoryType(mem); err != nil {
 			return fmt.Errorf("mem[%d]: %s", i, err.Error())
 		}
+		v.memTypes = append(v.memTypes, mem)
 	}
 	return nil
 }
 func (v *moduleValidator) validateGlobalSec() error {
 	for i, g := range v.module.GlobalSec {
+		if err := v.checkValType(g.Type.ValType); err != nil {
+			return fmt.Errorf("global[%d]: %s",
+				i+v.getImportedGlobalCount(), err.Error())
+		}
 		if err := v.validateConstExpr(g.Expr, g.Type.ValType); err != nil {
 			return fmt.Errorf("global[%d]: %s",
 				i+v.getImportedGlobalCount(), err.Error())
@@ -183,11 +214,13 @@ func (v *moduleValidator) validateStartSec() error {
 }
 func (v *moduleValidator) validateElemSec() error {
 	for i, elem := range v.module.ElemSec {
-		if int(elem.Table) >= v.getTableCount() {
-			return fmt.Errorf("elem[%d]: unknown table: %d", i, elem.Table)
-		}
-		if err := v.validateConstExpr(elem.Offset, binary.ValTypeI32); err != nil {
-			return fmt.Errorf("elem[%d]: %s", i, err.Error())
+		if !elem.IsDeclarative() {
+			if int(elem.Table) >= v.getTableCount() {
+				return fmt.Errorf("elem[%d]: unknown table: %d", i, elem.Table)
+			}
+			if err := v.validateConstExpr(elem.Offset, binary.ValTypeI32); err != nil {
+				return fmt.Errorf("elem[%d]: %s", i, err.Error())
+			}
 		}
 		for j, funcIdx := range elem.Init {
 			if int(funcIdx) >= v.getFuncCount() {
@@ -197,6 +230,37 @@ func (v *moduleValidator) validateElemSec() error {
 	}
 	return nil
 }
+
+// C.refs are the functions in elem segments, exports and constant
+// expressions, the start function and function bodies don't declare refs
+func (v *moduleValidator) initRefs() {
+	v.refs = map[uint32]bool{}
+	addRefsOf := func(expr binary.Expr) {
+		for _, instr := range expr {
+			if instr.Opcode == binary.RefFunc {
+				v.refs[instr.Args.(uint32)] = true
+			}
+		}
+	}
+	for _, g := range v.module.GlobalSec {
+		addRefsOf(g.Expr)
+	}
+	for _, exp := range v.module.ExportSec {
+		if exp.Desc.Tag == binary.ExportTagFunc {
+			v.refs[exp.Desc.Idx] = true
+		}
+	}
+	for _, elem := range v.module.ElemSec {
+		addRefsOf(elem.Offset)
+		for _, fIdx := range elem.Init {
+			v.refs[fIdx] = true
+		}
+	}
+	for _, data := range v.module.DataSec {
+		addRefsOf(data.Offset)
+	}
+}
+
 func (v *moduleValidator) validateCodeSec() error {
 	if len(v.module.CodeSec) != len(v.module.FuncSec) {
 		return fmt.Errorf("invalid code count")
@@ -205,10 +269,11 @@ func (v *moduleValidator) validateCodeSec() error {
 	for i, code := range v.module.CodeSec {
 		ftIdx := v.module.FuncSec[i]
 		ft := v.module.TypeSec[ftIdx]
-		err, maxOpds := validateCode(v, code, ft)
+		fIdx := v.getImportedFuncCount() + i
+		err, maxOpds := validateCode(v, fIdx, code, ft)
 		v.maxOperandStacks = append(v.maxOperandStacks, maxOpds)
 		if err != nil {
-			return fmt.Errorf("code#%d, %s", i, err.Error())
+			return err
 		}
 	}
 	return nil
@@ -218,55 +283,88 @@ func (v *moduleValidator) validateDataSec() error {
 		if int(data.Mem) >= v.getMemCount() {
 			return fmt.Errorf("data#%d: unknown memory: %d", i, data.Mem)
 		}
-		if err := v.validateConstExpr(data.Offset, binary.ValTypeI32); err != nil {
+		addrType := v.getAddrType(int(data.Mem))
+		if err := v.validateConstExpr(data.Offset, addrType); err != nil {
 			return fmt.Errorf("data#%d: %s", i, err.Error())
 		}
 	}
 	return nil
 }
 
-// TODO
+// constant expressions may use i32/i64 add, sub and mul (extended-const)
 func (v *moduleValidator) validateConstExpr(expr []binary.Instruction,
 	expectedType binary.ValType) error {
 
-	if len(expr) > 1 {
-		for _, instr := range expr {
-			switch instr.Opcode {
-			case binary.I32Const, binary.I64Const,
-				binary.F32Const, binary.F64Const,
-				binary.GlobalGet:
-			default:
-				return fmt.Errorf("constant expression required")
-			}
+	stack := make([]binary.ValType, 0, 2)
+	popOf := func(vt binary.ValType) bool {
+		n := len(stack)
+		if n == 0 || stack[n-1] != vt {
+			return false
 		}
-		return fmt.Errorf("type mismatch") // TODO
+		stack = stack[:n-1]
+		return true
 	}
 
-	var actualType byte = 0
-	if len(expr) > 0 {
-		switch expr[0].Opcode {
+	for _, instr := range expr {
+		if err := v.features.Check(instr.GetOpname(),
+			binary.OpcodeFeatures(instr.Opcode)); err != nil {
+			return err
+		}
+		switch instr.Opcode {
 		case binary.I32Const:
-			actualType = binary.ValTypeI32
+			stack = append(stack, binary.ValTypeI32)
 		case binary.I64Const:
-			actualType = binary.ValTypeI64
+			stack = append(stack, binary.ValTypeI64)
 		case binary.F32Const:
-			actualType = binary.ValTypeF32
+			stack = append(stack, binary.ValTypeF32)
 		case binary.F64Const:
-			actualType = binary.ValTypeF64
+			stack = append(stack, binary.ValTypeF64)
 		case binary.GlobalGet:
-			gIdx := expr[0].Args.(uint32)
+			gIdx := instr.Args.(uint32)
 			if int(gIdx) >= len(v.globalTypes) {
 				return fmt.Errorf("unknown global: %d", gIdx)
 			}
-			actualType = v.globalTypes[gIdx].ValType
+			if v.globalTypes[gIdx].Mut != binary.MutConst {
+				return fmt.Errorf("constant expression required")
+			}
+			stack = append(stack, v.globalTypes[gIdx].ValType)
+		case binary.RefNull:
+			vt := instr.Args.(binary.ValType)
+			if err := v.checkValType(vt); err != nil {
+				return err
+			}
+			stack = append(stack, vt)
+		case binary.RefFunc:
+			fIdx := instr.Args.(uint32)
+			ftIdx, ok := v.getFuncTypeIdx(int(fIdx))
+			if !ok {
+				return fmt.Errorf("unknown function: %d", fIdx)
+			}
+			stack = append(stack, binary.RefType(false, ftIdx))
+		case binary.I32Add, binary.I32Sub, binary.I32Mul:
+			if err := v.checkExtendedConst(instr); err != nil {
+				return err
+			}
+			if !popOf(binary.ValTypeI32) || !popOf(binary.ValTypeI32) {
+				return fmt.Errorf("type mismatch")
+			}
+			stack = append(stack, binary.ValTypeI32)
+		case binary.I64Add, binary.I64Sub, binary.I64Mul:
+			if err := v.checkExtendedConst(instr); err != nil {
+				return err
+			}
+			if !popOf(binary.ValTypeI64) || !popOf(binary.ValTypeI64) {
+				return fmt.Errorf("type mismatch")
+			}
+			stack = append(stack, binary.ValTypeI64)
 		default:
 			return fmt.Errorf("constant expression required")
 		}
 	}
-	if actualType != expectedType {
-		return fmt.Errorf("type mismatch") // TODO
-	}
 
+	if len(stack) != 1 || !v.isSubtype(stack[0], expectedType) {
+		return fmt.Errorf("type mismatch")
+	}
 	return nil
 }
 
@@ -313,35 +411,110 @@ func (v *moduleValidator) getGlobalCount() int {
 }
 
 func (v *moduleValidator) getFuncType(fIdx int) (binary.FuncType, bool) {
-	if fIdx < v.getImportedFuncCount() {
-		ftIdx := v.importedFuncs[fIdx].Desc.FuncType
+	if ftIdx, ok := v.getFuncTypeIdx(fIdx); ok {
 		return v.module.TypeSec[ftIdx], true
 	}
+	return binary.FuncType{}, false
+}
+func (v *moduleValidator) getFuncTypeIdx(fIdx int) (uint32, bool) {
+	if fIdx < v.getImportedFuncCount() {
+		return v.importedFuncs[fIdx].Desc.FuncType, true
+	}
 	if fIdx < v.getFuncCount() {
-		ftIdx := v.module.FuncSec[fIdx-v.getImportedFuncCount()]
-		return v.module.TypeSec[ftIdx], true
+		return v.module.FuncSec[fIdx-v.getImportedFuncCount()], true
 	}
-	return binary.FuncType{}, false
+	return 0, false
+}
+
+// i32 for 32-bit memories, i64 for 64-bit memories (memory64)
+func (v *moduleValidator) getAddrType(memIdx int) binary.ValType {
+	if memIdx < len(v.memTypes) && v.memTypes[memIdx].Is64() {
+		return binary.ValTypeI64
+	}
+	return binary.ValTypeI32
+}
+
+func (v *moduleValidator) checkExtendedConst(instr binary.Instruction) error {
+	return v.features.Check(instr.GetOpname()+" in constant expression",
+		binary.FeatureExtendedConst)
+}
+
+func (v *moduleValidator) checkValType(vt binary.ValType) error {
+	if err := v.features.Check(vt.String(), binary.ValTypeFeatures(vt)); err != nil {
+		return err
+	}
+	if idx, ok := vt.TypeIdx(); ok && int(idx) >= v.getTypeCount() {
+		return fmt.Errorf("unknown type: %d", idx)
+	}
+	return nil
+}
+
+func (v *moduleValidator) checkValTypes(vts []binary.ValType) error {
+	for _, vt := range vts {
+		if err := v.checkValType(vt); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// t1 <: t2, (ref $t) <: (ref null $t) <: funcref
+func (v *moduleValidator) isSubtype(t1, t2 binary.ValType) bool {
+	if t1 == t2 {
+		return true
+	}
+	if !t1.IsRef() || !t2.IsRef() {
+		return false
+	}
+	if t1 == binary.ValTypeExternRef || t2 == binary.ValTypeExternRef {
+		return false // extern is not related to func types
+	}
+	if t1.IsNullable() && !t2.IsNullable() {
+		return false
+	}
+	idx2, ok2 := t2.TypeIdx()
+	if !ok2 {
+		return true
+	}
+	idx1, ok1 := t1.TypeIdx()
+	if !ok1 {
+		return false
+	}
+	return idx1 == idx2 ||
+		v.module.TypeSec[idx1].Equal(v.module.TypeSec[idx2])
 }
 
 func validateTableType(limits binary.Limits) error {
+	if limits.Is64() {
+		return fmt.Errorf("invalid limits flag: %d", limits.Tag)
+	}
 	return validateLimits(limits, 1<<31, "table")
 }
-func validateMemoryType(limits binary.Limits) error {
-	return validateLimits(limits, 1<<16, "mem")
+func (v *moduleValidator) validateMemoryType(limits binary.Limits) error {
+	if limits.Is64() {
+		if err := v.features.Check("64-bit memory", binary.FeatureMemory64); err != nil {
+			return err
+		}
+		return validateLimits(limits, binary.MaxPageCount64, "mem64")
+	}
+	return validateLimits(limits, binary.MaxPageCount, "mem")
 }
-func validateLimits(limits binary.Limits, k uint32, kind string) error {
+func validateLimits(limits binary.Limits, k uint64, kind string) error {
 	if limits.Min > k {
 		if kind == "mem" {
 			return fmt.Errorf("memory size must be at most 65536 pages (4GiB)")
+		} else if kind == "mem64" {
+			return fmt.Errorf("memory size must be at most 2^48 pages")
 		} else {
 			// TODO
 		}
 	}
-	if limits.Tag == 1 {
+	if limits.HasMax() {
 		if limits.Max > k {
 			if kind == "mem" {
 				return fmt.Errorf("memory size must be at most 65536 pages (4GiB)")
+			} else if kind == "mem64" {
+				return fmt.Errorf("memory size must be at most 2^48 pages")
 			} else {
 				// TODO
 			}